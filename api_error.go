@@ -0,0 +1,71 @@
+package deeplgo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// APIError is returned for every response of DeepL API with an HTTP status
+// code of error. It unwraps to the sentinel error of its status code, like
+// ErrQuotaExceeded, so it can be checked with errors.Is.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Detail     string
+	// RetryAfter is the delay asked by header `Retry-After`, zero if not set
+	RetryAfter time.Duration
+	// RequestID is the identifier of request given by server, if any
+	RequestID string
+
+	err error
+}
+
+func (e *APIError) Error() string {
+	errText := fmt.Sprintf("unknown error, status code: %d", e.StatusCode)
+	if e.err != nil {
+		errText = e.err.Error()
+	}
+	if e.Message != "" {
+		errText += ", message : " + e.Message
+	}
+	if e.Detail != "" {
+		errText += ", detail : " + e.Detail
+	}
+
+	return errText
+}
+
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// parseRetryAfter read header `Retry-After` given in seconds or as HTTP date
+func parseRetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+
+	return 0
+}
+
+func requestID(header http.Header) string {
+	for _, key := range []string{"X-Request-Id", "X-Trace-Id"} {
+		if id := header.Get(key); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
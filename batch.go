@@ -0,0 +1,210 @@
+package deeplgo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Limits of a single translation request accepted by DeepL
+const (
+	maxTextsPerRequest = 50
+	maxRequestSize     = 128 * 1024
+)
+
+// Number of translation requests sent in parallel by TranslateBatch
+const defaultBatchConcurrency = 4
+
+// BatchResult is the translation of one text given to TranslateBatch, Err is
+// set when this text could not be translated.
+type BatchResult struct {
+	Translation Translation
+	Err         error
+}
+
+// TranslateBatch translate any number of texts into targetLang. Texts are
+// packed into requests within DeepL limits of count and size, sent in
+// parallel, and results are returned in the same order as texts. A text larger
+// than a request is split at paragraph then sentence boundaries, its parts are
+// translated and joined back with original whitespace. Error is
+// only returned when options are invalid, failure of a request is reported on
+// each result of its texts.
+func (c *Client) TranslateBatch(texts []string, targetLang string, opts ...TranslateOption) ([]BatchResult, error) {
+	return c.TranslateBatchContext(context.Background(), texts, targetLang, opts...)
+}
+
+func (c *Client) TranslateBatchContext(ctx context.Context, texts []string, targetLang string, opts ...TranslateOption) ([]BatchResult, error) {
+	options := TranslateOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if err := c.httpClient.validate.Struct(translateRequest{
+		Text:             []string{""},
+		TargetLang:       targetLang,
+		TranslateOptions: options,
+	}); err != nil {
+		return nil, err
+	}
+	if err := c.preflightTranslate(ctx, options.SourceLang, targetLang, options.Formality, options.GlossaryID); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, len(texts))
+	parts, chunks, err := packTexts(texts, targetLang, options, results)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := c.batchConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	semaphore := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for _, chunk := range chunks {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(chunk []int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			c.translateChunk(ctx, parts, chunk, targetLang, options)
+		}(chunk)
+	}
+	wg.Wait()
+
+	for i, part := range parts {
+		result := &results[part.index]
+		if result.Err != nil {
+			continue
+		} else if part.err != nil {
+			result.Err = part.err
+			result.Translation = Translation{}
+			continue
+		}
+
+		if i == 0 || parts[i-1].index != part.index {
+			result.Translation = part.translation
+		} else {
+			result.Translation.Text += part.translation.Text
+			result.Translation.BilledCharacters += part.translation.BilledCharacters
+		}
+		result.Translation.Text += part.separator
+	}
+
+	return results, nil
+}
+
+// batchPart is a text given to TranslateBatch, or a chunk of it when text is
+// too large for a single request. Translations of chunks are joined with
+// their original separator.
+type batchPart struct {
+	index       int
+	text        string
+	separator   string
+	openTags    int
+	closeTags   int
+	translation Translation
+	err         error
+}
+
+// translateChunk translate parts at indexes of chunk in a single request and
+// store translations or error in these parts.
+func (c *Client) translateChunk(ctx context.Context, parts []batchPart, chunk []int, targetLang string, options TranslateOptions) {
+	chunkTexts := make([]string, len(chunk))
+	for i, index := range chunk {
+		chunkTexts[i] = parts[index].text
+	}
+
+	res, err := c.TranslateTextContext(ctx, chunkTexts, targetLang, WithOptions(options))
+	if err == nil && len(res.Translations) != len(chunk) {
+		err = fmt.Errorf("expected %d translations, got %d", len(chunk), len(res.Translations))
+	}
+
+	for i, index := range chunk {
+		if err != nil {
+			parts[index].err = err
+			continue
+		}
+		translation := res.Translations[i]
+		text, unwrapErr := unwrapXMLChunk(translation.Text, parts[index].openTags, parts[index].closeTags)
+		if unwrapErr != nil {
+			parts[index].err = unwrapErr
+			continue
+		}
+		translation.Text = text
+		parts[index].translation = translation
+	}
+}
+
+// packTexts split texts too large for a request in parts then group indexes
+// of parts in chunks respecting maxTextsPerRequest and maxRequestSize, in
+// order of texts. Texts which can't be sent have their error set in results
+// and have no part.
+func packTexts(texts []string, targetLang string, options TranslateOptions, results []BatchResult) ([]batchPart, [][]int, error) {
+	base, err := encodeJSON(translateRequest{Text: []string{}, TargetLang: targetLang, TranslateOptions: options})
+	if err != nil {
+		return nil, nil, err
+	}
+	maxTextSize := maxRequestSize - len(base)
+
+	parts := []batchPart{}
+	for i, text := range texts {
+		if options.TagHandling == TagHandlingXML {
+			if err := checkWellFormedXML(text); err != nil {
+				results[i].Err = &MarkupError{Index: i, Err: err}
+				continue
+			}
+		}
+
+		textChunks, err := splitText(text, maxTextSize, options.TagHandling)
+		if err != nil {
+			results[i].Err = fmt.Errorf("text %d: %w", i, err)
+			continue
+		}
+		for _, textChunk := range textChunks {
+			parts = append(parts, batchPart{
+				index:     i,
+				text:      textChunk.text,
+				separator: textChunk.separator,
+				openTags:  textChunk.openTags,
+				closeTags: textChunk.closeTags,
+			})
+		}
+	}
+
+	chunks := [][]int{}
+	chunk := []int{}
+	chunkSize := len(base)
+	for i, part := range parts {
+		size, err := textRequestSize(part.text)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(chunk) == maxTextsPerRequest || chunkSize+size > maxRequestSize {
+			chunks = append(chunks, chunk)
+			chunk = []int{}
+			chunkSize = len(base)
+		}
+		chunk = append(chunk, i)
+		chunkSize += size
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return parts, chunks, nil
+}
+
+// textRequestSize return number of bytes text add to a JSON request, with
+// escaping and separator.
+func textRequestSize(text string) (int, error) {
+	encoded, err := encodeJSON(text)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(encoded) + 1, nil
+}
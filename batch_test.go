@@ -0,0 +1,160 @@
+package deeplgo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newUpperServer return a server translating texts in upper case and record
+// number of texts of each request
+func newUpperServer(t *testing.T, requests *[]int) *httptest.Server {
+	mu := sync.Mutex{}
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body := translateRequest{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+			mu.Lock()
+			*requests = append(*requests, len(body.Text))
+			mu.Unlock()
+
+			res := Translations{}
+			for _, text := range body.Text {
+				if text == "fail" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				res.Translations = append(res.Translations, Translation{
+					DetectedSourceLanguage: "EN",
+					Text:                   strings.ToUpper(text),
+				})
+			}
+			json.NewEncoder(w).Encode(res)
+		},
+	))
+}
+
+// Test Client function TranslateBatch with more texts than request limit
+// Function must split texts in several requests and keep order
+func Test_Client_TranslateBatchCount(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+
+	texts := make([]string, 120)
+	for i := range texts {
+		texts[i] = strings.Repeat("a", i%7+1)
+	}
+	res, err := c.TranslateBatch(texts, "DE")
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int{50, 50, 20}, requests)
+	for i, r := range res {
+		assert.Nil(t, r.Err)
+		assert.Equal(t, strings.ToUpper(texts[i]), r.Translation.Text)
+	}
+}
+
+// Test Client function TranslateBatch with texts bigger than request size
+// Function must split texts in requests under size limit and reject text
+// which can't fit in any request
+func Test_Client_TranslateBatchSize(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+
+	large := strings.Repeat("a", 50*1024)
+	texts := []string{large, large, large, strings.Repeat("b", maxRequestSize)}
+	res, err := c.TranslateBatch(texts, "DE")
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int{2, 1}, requests)
+	assert.Nil(t, res[2].Err)
+	assert.ErrorIs(t, res[3].Err, ErrRequestSizeExceedsLimit)
+}
+
+// Test Client function TranslateBatch with a request failing
+// Function must report error on texts of this request only
+func Test_Client_TranslateBatchError(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL), WithBatchConcurrency(1))
+
+	texts := make([]string, 60)
+	for i := range texts {
+		texts[i] = "ok"
+	}
+	texts[55] = "fail"
+	res, err := c.TranslateBatch(texts, "DE")
+
+	assert.Nil(t, err)
+	assert.Nil(t, res[49].Err)
+	assert.Equal(t, "OK", res[49].Translation.Text)
+	assert.ErrorIs(t, res[50].Err, ErrBadRequest)
+	assert.ErrorIs(t, res[59].Err, ErrBadRequest)
+}
+
+// Test Client function TranslateBatch with invalid option
+// Function must return error without sending request
+func Test_Client_TranslateBatchInvalidOptions(t *testing.T) {
+	c := NewClient("NO_API_KEY", WithServerURL("http://127.0.0.1:0"))
+
+	res, err := c.TranslateBatch([]string{"Hello"}, "DE", WithFormality("very_formal"))
+
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+// Test Client function TranslateBatch with a text larger than a request
+// Function must split text on paragraphs and join translations with original
+// whitespace
+func Test_Client_TranslateBatchLongText(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+
+	paragraph := strings.Repeat("Lorem ipsum dolor sit amet. ", 2000)
+	text := paragraph + "\n\n" + paragraph + "\n \n" + paragraph
+	res, err := c.TranslateBatch([]string{"Hello", text}, "DE")
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int{2, 1}, requests)
+	assert.Nil(t, res[1].Err)
+	assert.Equal(t, "HELLO", res[0].Translation.Text)
+	assert.Equal(t, strings.ToUpper(text), res[1].Translation.Text)
+}
+
+// Test Client function TranslateBatch with a XML document with a single root
+// larger than a request
+// Function must split inside root element and join translations without the
+// added enclosing tags
+func Test_Client_TranslateBatchLongXML(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+
+	paragraph := "<p>" + strings.Repeat("Lorem ipsum dolor sit amet. ", 2000) + "</p>"
+	text := `<topic id="t1"><title>Lorem</title><body>` + strings.Repeat(paragraph+"\n", 5) + "</body></topic>"
+	res, err := c.TranslateBatch([]string{text}, "DE", WithTagHandling(TagHandlingXML))
+
+	assert.Nil(t, err)
+	assert.Greater(t, len(requests), 1)
+	assert.Nil(t, res[0].Err)
+	assert.Equal(t, strings.ToUpper(text), res[0].Translation.Text)
+}
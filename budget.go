@@ -0,0 +1,364 @@
+package deeplgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Default interval between two GetUsage calls of a BudgetGuard
+const defaultUsageSyncInterval = time.Minute
+
+// Shortest sleep of a queued translation between two checks of budget
+const minBudgetWait = 100 * time.Millisecond
+
+// Part of a budget used at which BudgetGuard call its threshold callback
+var budgetThresholds = []float64{0.5, 0.8, 0.95}
+
+var ErrBudgetExceeded = errors.New("translation budget exceeded")
+
+type BudgetKind string
+
+const (
+	// BudgetPeriod is the budget of the billing period, the soft limit or the
+	// character limit of the account
+	BudgetPeriod BudgetKind = "period"
+	// BudgetDaily is the budget of characters per day
+	BudgetDaily BudgetKind = "daily"
+)
+
+// BudgetError is returned by BudgetGuard for a translation which would exceed
+// a budget, it wraps ErrBudgetExceeded.
+type BudgetError struct {
+	Kind      BudgetKind
+	Used      int
+	Requested int
+	Limit     int
+}
+
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("%s: %d characters requested, %d of %d %s budget used", ErrBudgetExceeded, e.Requested, e.Used, e.Limit, e.Kind)
+}
+
+func (e *BudgetError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// BudgetEvent is given to threshold callback when a part of a budget is used
+type BudgetEvent struct {
+	Kind      BudgetKind
+	Threshold float64
+	Used      int
+	Limit     int
+}
+
+// BudgetGuard translate texts with another Translator while keeping
+// characters sent within a budget for the billing period and per day.
+// Characters are counted locally and re-synced periodically with GetUsage,
+// a translation exceeding a budget is refused, or queued until budget is
+// available with WithBudgetQueue.
+type BudgetGuard struct {
+	translator   Translator
+	usage        UsageReporter
+	softLimit    int
+	dailyBudget  int
+	syncInterval time.Duration
+	queue        bool
+	onThreshold  func(BudgetEvent)
+	now          func() time.Time
+
+	mu        sync.Mutex
+	used      int
+	limit     int
+	inFlight  int
+	syncedAt  time.Time
+	syncing   bool
+	day       time.Time
+	usedToday int
+	fired     map[BudgetKind]int
+	changed   chan struct{}
+}
+
+// BudgetOption configure a BudgetGuard created by NewBudgetGuard
+type BudgetOption func(*BudgetGuard)
+
+// WithSoftLimit set characters allowed in billing period, below character
+// limit of account which is used otherwise.
+func WithSoftLimit(characters int) BudgetOption {
+	return func(g *BudgetGuard) {
+		g.softLimit = characters
+	}
+}
+
+// WithDailyBudget set characters allowed per day, a day start at midnight in
+// local time.
+func WithDailyBudget(characters int) BudgetOption {
+	return func(g *BudgetGuard) {
+		g.dailyBudget = characters
+	}
+}
+
+// WithUsageSyncInterval set interval between two GetUsage calls
+func WithUsageSyncInterval(interval time.Duration) BudgetOption {
+	return func(g *BudgetGuard) {
+		if interval > 0 {
+			g.syncInterval = interval
+		}
+	}
+}
+
+// WithBudgetQueue make translations exceeding a budget wait until budget is
+// available or their context is done, instead of being refused. A
+// translation larger than a whole budget is still refused.
+func WithBudgetQueue() BudgetOption {
+	return func(g *BudgetGuard) {
+		g.queue = true
+	}
+}
+
+// WithThresholdCallback set function called once when 50%, 80% then 95% of a
+// budget is used. It is called without lock held.
+func WithThresholdCallback(callback func(BudgetEvent)) BudgetOption {
+	return func(g *BudgetGuard) {
+		g.onThreshold = callback
+	}
+}
+
+func NewBudgetGuard(translator Translator, usage UsageReporter, opts ...BudgetOption) *BudgetGuard {
+	g := &BudgetGuard{
+		translator:   translator,
+		usage:        usage,
+		syncInterval: defaultUsageSyncInterval,
+		now:          time.Now,
+		fired:        map[BudgetKind]int{},
+		changed:      make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// BudgetUsage is the state of budgets of a BudgetGuard, limits are zero when
+// there is no such budget.
+type BudgetUsage struct {
+	Used        int
+	Limit       int
+	UsedToday   int
+	DailyBudget int
+}
+
+func (g *BudgetGuard) Usage() BudgetUsage {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.rollDay()
+	return BudgetUsage{Used: g.used, Limit: g.periodLimit(), UsedToday: g.usedToday, DailyBudget: g.dailyBudget}
+}
+
+func (g *BudgetGuard) TranslateText(texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	return g.TranslateTextContext(context.Background(), texts, targetLang, opts...)
+}
+
+// TranslateTextContext reserve characters of texts in budgets then translate
+// them, characters are given back when translation fail.
+func (g *BudgetGuard) TranslateTextContext(ctx context.Context, texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	characters := 0
+	for _, text := range texts {
+		characters += utf8.RuneCountInString(text)
+	}
+
+	day, err := g.reserve(ctx, characters)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := g.translator.TranslateTextContext(ctx, texts, targetLang, opts...)
+
+	g.mu.Lock()
+	g.inFlight -= characters
+	if err != nil {
+		g.used -= characters
+		// Daily budget was reset if day changed during translation
+		g.rollDay()
+		if g.day.Equal(day) {
+			g.usedToday -= characters
+		}
+		g.notify()
+	}
+	g.mu.Unlock()
+
+	return res, err
+}
+
+// reserve add characters to budgets, waiting for budget with a queue, and
+// return day of daily budget characters were added to
+func (g *BudgetGuard) reserve(ctx context.Context, characters int) (time.Time, error) {
+	for {
+		g.sync(ctx)
+
+		g.mu.Lock()
+		if g.syncedAt.IsZero() && g.syncing {
+			// Limit and used characters are unknown until first sync, done
+			// by another translation
+			changed := g.changed
+			g.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return time.Time{}, ctx.Err()
+			case <-changed:
+			}
+			continue
+		}
+
+		g.rollDay()
+		err := g.check(characters)
+		if err == nil {
+			g.used += characters
+			g.usedToday += characters
+			g.inFlight += characters
+			day := g.day
+			events := g.crossedThresholds()
+			g.mu.Unlock()
+			g.fire(events)
+			return day, nil
+		}
+
+		budgetErr := err.(*BudgetError)
+		if !g.queue || characters > budgetErr.Limit {
+			g.mu.Unlock()
+			return time.Time{}, err
+		}
+		changed := g.changed
+		syncing := g.syncing
+		wait := g.syncedAt.Add(g.syncInterval).Sub(g.now())
+		if budgetErr.Kind == BudgetDaily {
+			wait = g.day.AddDate(0, 0, 1).Sub(g.now())
+		}
+		g.mu.Unlock()
+
+		// A sync in progress notify when done, sleeping until then would spin
+		if syncing || wait <= 0 {
+			wait = minBudgetWait
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return time.Time{}, ctx.Err()
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// check return a BudgetError if characters exceed a budget, g.mu must be held
+func (g *BudgetGuard) check(characters int) error {
+	if limit := g.periodLimit(); limit > 0 && g.used+characters > limit {
+		return &BudgetError{Kind: BudgetPeriod, Used: g.used, Requested: characters, Limit: limit}
+	}
+	if g.dailyBudget > 0 && g.usedToday+characters > g.dailyBudget {
+		return &BudgetError{Kind: BudgetDaily, Used: g.usedToday, Requested: characters, Limit: g.dailyBudget}
+	}
+
+	return nil
+}
+
+// periodLimit return soft limit, or character limit of account when not
+// set, g.mu must be held
+func (g *BudgetGuard) periodLimit() int {
+	if g.softLimit > 0 {
+		return g.softLimit
+	}
+
+	return g.limit
+}
+
+// sync update characters used from GetUsage once sync interval is elapsed. A
+// failure keep local count until next interval.
+func (g *BudgetGuard) sync(ctx context.Context) {
+	g.mu.Lock()
+	if g.syncing || (!g.syncedAt.IsZero() && g.now().Before(g.syncedAt.Add(g.syncInterval))) {
+		g.mu.Unlock()
+		return
+	}
+	g.syncing = true
+	g.mu.Unlock()
+
+	usage, err := g.usage.GetUsageContext(ctx)
+
+	g.mu.Lock()
+	g.syncing = false
+	g.syncedAt = g.now()
+	if err == nil {
+		// Translations in flight may not be counted by DeepL yet
+		g.used = usage.CharacterCount + g.inFlight
+		g.limit = usage.CharacterLimit
+		if g.fired[BudgetPeriod] > 0 && !g.reached(g.used, g.periodLimit(), budgetThresholds[g.fired[BudgetPeriod]-1]) {
+			// A new billing period started
+			g.fired[BudgetPeriod] = 0
+		}
+	}
+	// Wake up translations waiting for this sync, even when it failed
+	g.notify()
+	g.mu.Unlock()
+}
+
+// rollDay reset daily budget when day changed, g.mu must be held
+func (g *BudgetGuard) rollDay() {
+	now := g.now()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if !day.Equal(g.day) {
+		g.day = day
+		g.usedToday = 0
+		g.fired[BudgetDaily] = 0
+		g.notify()
+	}
+}
+
+// notify wake up translations waiting for budget, g.mu must be held
+func (g *BudgetGuard) notify() {
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+// crossedThresholds return events of thresholds reached since last call,
+// g.mu must be held
+func (g *BudgetGuard) crossedThresholds() []BudgetEvent {
+	events := []BudgetEvent{}
+	budgets := []struct {
+		kind  BudgetKind
+		used  int
+		limit int
+	}{
+		{BudgetPeriod, g.used, g.periodLimit()},
+		{BudgetDaily, g.usedToday, g.dailyBudget},
+	}
+	for _, budget := range budgets {
+		for g.fired[budget.kind] < len(budgetThresholds) && g.reached(budget.used, budget.limit, budgetThresholds[g.fired[budget.kind]]) {
+			threshold := budgetThresholds[g.fired[budget.kind]]
+			events = append(events, BudgetEvent{Kind: budget.kind, Threshold: threshold, Used: budget.used, Limit: budget.limit})
+			g.fired[budget.kind]++
+		}
+	}
+
+	return events
+}
+
+func (g *BudgetGuard) reached(used int, limit int, threshold float64) bool {
+	return limit > 0 && float64(used) >= threshold*float64(limit)
+}
+
+func (g *BudgetGuard) fire(events []BudgetEvent) {
+	if g.onThreshold == nil {
+		return
+	}
+	for _, event := range events {
+		g.onThreshold(event)
+	}
+}
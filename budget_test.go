@@ -0,0 +1,250 @@
+package deeplgo
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBudgetGuard(server *deepltest.Server, opts ...BudgetOption) *BudgetGuard {
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()), WithRetryPolicy(NoRetryPolicy()))
+
+	return NewBudgetGuard(c, c, opts...)
+}
+
+// Test BudgetGuard with a soft limit
+// Translation exceeding limit must be refused without request, usage must be
+// re-synced after interval
+func Test_BudgetGuard_SoftLimit(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	clock := &fakeClock{now: time.Now()}
+	guard := newTestBudgetGuard(server, WithSoftLimit(10))
+	guard.now = clock.Now
+
+	_, err := guard.TranslateText([]string{"Hello"}, "DE")
+	assert.Nil(t, err)
+
+	_, err = guard.TranslateText([]string{"Hello!"}, "DE")
+	budgetErr := &BudgetError{}
+	if assert.ErrorAs(t, err, &budgetErr) {
+		assert.Equal(t, &BudgetError{Kind: BudgetPeriod, Used: 5, Requested: 6, Limit: 10}, budgetErr)
+	}
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+	assert.Equal(t, 5, server.CharacterCount())
+
+	// Characters used by another client are seen after next sync
+	server.SetCharacterCount(8)
+	_, err = guard.TranslateText([]string{"abc"}, "DE")
+	assert.Nil(t, err)
+	clock.Add(2 * defaultUsageSyncInterval)
+	_, err = guard.TranslateText([]string{"abc"}, "DE")
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+	assert.Equal(t, BudgetUsage{Used: 11, Limit: 10, UsedToday: 8}, guard.Usage())
+}
+
+// Test BudgetGuard with a daily budget
+// Budget must be reset on next day and failed translations given back
+func Test_BudgetGuard_DailyBudget(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	clock := &fakeClock{now: time.Date(2024, 5, 1, 23, 0, 0, 0, time.Local)}
+	guard := newTestBudgetGuard(server, WithDailyBudget(10))
+	guard.now = clock.Now
+
+	_, err := guard.TranslateText([]string{"Hello"}, "DE")
+	assert.Nil(t, err)
+
+	server.FailNext(503, 1)
+	_, err = guard.TranslateText([]string{"World"}, "DE")
+	assert.ErrorIs(t, err, ErrResourceUnavailable)
+
+	_, err = guard.TranslateText([]string{"World"}, "DE")
+	assert.Nil(t, err)
+	_, err = guard.TranslateText([]string{"!"}, "DE")
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+
+	clock.Add(2 * time.Hour)
+	_, err = guard.TranslateText([]string{"!"}, "DE")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, guard.Usage().UsedToday)
+}
+
+// Test BudgetGuard with a queue
+// Translation must wait for budget, be refused when larger than budget and
+// give up with its context
+func Test_BudgetGuard_Queue(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	server.SetCharacterCount(8)
+	guard := newTestBudgetGuard(server, WithSoftLimit(10), WithBudgetQueue(), WithUsageSyncInterval(10*time.Millisecond))
+
+	_, err := guard.TranslateText([]string{strings.Repeat("a", 11)}, "DE")
+	assert.ErrorIs(t, err, ErrBudgetExceeded)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	_, err = guard.TranslateTextContext(ctx, []string{"abc"}, "DE")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// A new billing period start while translation wait
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		server.SetCharacterCount(0)
+	}()
+	res, err := guard.TranslateText([]string{"abc"}, "DE")
+	assert.Nil(t, err)
+	assert.Equal(t, deepltest.FakeTranslation("abc", "DE"), res.Translations[0].Text)
+}
+
+// slowUsage is a UsageReporter answering after a delay
+type slowUsage struct {
+	delay time.Duration
+	mu    sync.Mutex
+	usage Usage
+}
+
+func (u *slowUsage) GetUsage() (*Usage, error) {
+	return u.GetUsageContext(context.Background())
+}
+
+func (u *slowUsage) GetUsageContext(ctx context.Context) (*Usage, error) {
+	time.Sleep(u.delay)
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	usage := u.usage
+	return &usage, nil
+}
+
+func (u *slowUsage) set(count int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.usage.CharacterCount = count
+}
+
+// Test BudgetGuard queue with a slow usage sync
+// Translations queued while another one sync usage must wait for the sync
+// instead of checking budget in a loop
+func Test_BudgetGuard_QueueSlowSync(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()), WithRetryPolicy(NoRetryPolicy()))
+	usage := &slowUsage{usage: Usage{CharacterCount: 8, CharacterLimit: 100}}
+	guard := NewBudgetGuard(c, usage, WithSoftLimit(10), WithBudgetQueue(), WithUsageSyncInterval(10*time.Millisecond))
+	var nowCalls int64
+	guard.now = func() time.Time {
+		atomic.AddInt64(&nowCalls, 1)
+		return time.Now()
+	}
+
+	_, err := guard.TranslateText([]string{"a"}, "DE")
+	assert.Nil(t, err)
+
+	// A new billing period is seen by the next sync, which is slow
+	usage.set(0)
+	usage.delay = 200 * time.Millisecond
+	time.Sleep(20 * time.Millisecond)
+	atomic.StoreInt64(&nowCalls, 0)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := guard.TranslateText([]string{"ab"}, "DE")
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Less(t, atomic.LoadInt64(&nowCalls), int64(1000))
+	assert.Equal(t, 10, guard.Usage().Used)
+}
+
+// Test BudgetGuard with concurrent translations before first usage sync
+// Translations must wait for sync in progress and be checked against limit of
+// account, even without a queue
+func Test_BudgetGuard_ConcurrentFirstSync(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()), WithRetryPolicy(NoRetryPolicy()))
+	usage := &slowUsage{delay: 100 * time.Millisecond, usage: Usage{CharacterCount: 95, CharacterLimit: 100}}
+	guard := NewBudgetGuard(c, usage)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := guard.TranslateText([]string{"abcdef"}, "DE")
+			assert.ErrorIs(t, err, ErrBudgetExceeded)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 0, server.CharacterCount())
+	assert.Equal(t, BudgetUsage{Used: 95, Limit: 100}, guard.Usage())
+}
+
+// failingTranslator is a Translator failing after calling before
+type failingTranslator struct {
+	before func()
+}
+
+func (ft failingTranslator) TranslateText(texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	return ft.TranslateTextContext(context.Background(), texts, targetLang, opts...)
+}
+
+func (ft failingTranslator) TranslateTextContext(ctx context.Context, texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	ft.before()
+	return nil, ErrResourceUnavailable
+}
+
+// Test BudgetGuard with a translation failing on next day
+// Characters must not be given back to daily budget of next day
+func Test_BudgetGuard_FailureNextDay(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 5, 1, 23, 0, 0, 0, time.Local)}
+	usage := &slowUsage{usage: Usage{CharacterCount: 0, CharacterLimit: 100}}
+	var guard *BudgetGuard
+	guard = NewBudgetGuard(failingTranslator{before: func() {
+		// Next day start while translating
+		clock.Add(2 * time.Hour)
+		guard.Usage()
+	}}, usage, WithDailyBudget(10))
+	guard.now = clock.Now
+
+	_, err := guard.TranslateText([]string{"Hello"}, "DE")
+
+	assert.ErrorIs(t, err, ErrResourceUnavailable)
+	assert.Equal(t, BudgetUsage{Used: 0, Limit: 100, UsedToday: 0, DailyBudget: 10}, guard.Usage())
+}
+
+// Test BudgetGuard threshold callback
+// Callback must be called once per threshold crossed
+func Test_BudgetGuard_Thresholds(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	events := []BudgetEvent{}
+	guard := newTestBudgetGuard(server, WithSoftLimit(100), WithThresholdCallback(func(event BudgetEvent) {
+		events = append(events, event)
+	}))
+
+	for _, size := range []int{40, 10, 35, 1, 10} {
+		_, err := guard.TranslateText([]string{strings.Repeat("a", size)}, "DE")
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, []BudgetEvent{
+		{Kind: BudgetPeriod, Threshold: 0.5, Used: 50, Limit: 100},
+		{Kind: BudgetPeriod, Threshold: 0.8, Used: 85, Limit: 100},
+		{Kind: BudgetPeriod, Threshold: 0.95, Used: 96, Limit: 100},
+	}, events)
+}
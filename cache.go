@@ -0,0 +1,229 @@
+package deeplgo
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+)
+
+// Cache store translations by key, implementations must be safe for
+// concurrent use.
+type Cache interface {
+	Get(key string) (Translation, bool)
+	Set(key string, translation Translation)
+}
+
+// CachedTranslator translate texts with another Translator and store
+// translations in a Cache, a text already translated with same target language
+// and options is not sent again.
+type CachedTranslator struct {
+	translator Translator
+	cache      Cache
+	hits       atomic.Int64
+	misses     atomic.Int64
+}
+
+func NewCachedTranslator(translator Translator, cache Cache) *CachedTranslator {
+	return &CachedTranslator{
+		translator: translator,
+		cache:      cache,
+	}
+}
+
+// Hits return number of texts found in cache
+func (ct *CachedTranslator) Hits() int64 {
+	return ct.hits.Load()
+}
+
+// Misses return number of texts not found in cache and sent to translator
+func (ct *CachedTranslator) Misses() int64 {
+	return ct.misses.Load()
+}
+
+func (ct *CachedTranslator) TranslateText(texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	return ct.TranslateTextContext(context.Background(), texts, targetLang, opts...)
+}
+
+// TranslateTextContext translate texts not found in cache in a single request
+// and return translations of all texts in the same order as texts.
+func (ct *CachedTranslator) TranslateTextContext(ctx context.Context, texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	options := TranslateOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	res := Translations{Translations: make([]Translation, len(texts))}
+	keys := make([]string, len(texts))
+	missTexts := []string{}
+	missIndexes := []int{}
+	for i, text := range texts {
+		keys[i] = cacheKey(text, targetLang, options)
+		if translation, ok := ct.cache.Get(keys[i]); ok {
+			res.Translations[i] = translation
+			continue
+		}
+		missTexts = append(missTexts, text)
+		missIndexes = append(missIndexes, i)
+	}
+
+	if len(missTexts) > 0 || len(texts) == 0 {
+		missRes, err := ct.translator.TranslateTextContext(ctx, missTexts, targetLang, WithOptions(options))
+		if err != nil {
+			return nil, err
+		}
+		if len(missRes.Translations) != len(missTexts) {
+			return nil, fmt.Errorf("expected %d translations, got %d", len(missTexts), len(missRes.Translations))
+		}
+		for i, translation := range missRes.Translations {
+			index := missIndexes[i]
+			res.Translations[index] = translation
+			// Characters of a cache hit are not billed again
+			cached := translation
+			cached.BilledCharacters = 0
+			ct.cache.Set(keys[index], cached)
+		}
+	}
+
+	ct.hits.Add(int64(len(texts) - len(missTexts)))
+	ct.misses.Add(int64(len(missTexts)))
+
+	return &res, nil
+}
+
+// cacheKey hash text with every parameter which can change its translation,
+// showing billed characters doesn't
+func cacheKey(text string, targetLang string, options TranslateOptions) string {
+	options.ShowBilledCharacters = false
+	data, _ := json.Marshal(struct {
+		Text       string           `json:"text"`
+		TargetLang string           `json:"target_lang"`
+		Options    TranslateOptions `json:"options"`
+	}{text, targetLang, options})
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// MemoryCache keep up to capacity translations in memory, least recently used
+// translations are evicted first.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type memoryCacheEntry struct {
+	key         string
+	translation Translation
+}
+
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+func (mc *MemoryCache) Get(key string) (Translation, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	element, ok := mc.entries[key]
+	if !ok {
+		return Translation{}, false
+	}
+	mc.order.MoveToFront(element)
+
+	return element.Value.(*memoryCacheEntry).translation, true
+}
+
+func (mc *MemoryCache) Set(key string, translation Translation) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if element, ok := mc.entries[key]; ok {
+		element.Value.(*memoryCacheEntry).translation = translation
+		mc.order.MoveToFront(element)
+		return
+	}
+
+	mc.entries[key] = mc.order.PushFront(&memoryCacheEntry{key: key, translation: translation})
+	for mc.capacity > 0 && mc.order.Len() > mc.capacity {
+		oldest := mc.order.Back()
+		mc.order.Remove(oldest)
+		delete(mc.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+func (mc *MemoryCache) Len() int {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	return mc.order.Len()
+}
+
+// FileCache store each translation as a JSON file in a directory, so cache
+// is kept between runs. Files are written atomically.
+type FileCache struct {
+	dir string
+}
+
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+func (fc *FileCache) Get(key string) (Translation, bool) {
+	data, err := os.ReadFile(fc.path(key))
+	if err != nil {
+		return Translation{}, false
+	}
+
+	translation := Translation{}
+	if err := json.Unmarshal(data, &translation); err != nil {
+		return Translation{}, false
+	}
+
+	return translation, true
+}
+
+// Set write translation, a failure only means translation is not cached
+func (fc *FileCache) Set(key string, translation Translation) {
+	data, err := json.Marshal(translation)
+	if err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(fc.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, errWrite := tmp.Write(data)
+	errClose := tmp.Close()
+	if errWrite != nil || errClose != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), fc.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// path return file of key, key is hashed so any key give a valid file name
+func (fc *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(fc.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,126 @@
+package deeplgo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+// countTranslator is a Translator returning count translations whatever texts
+type countTranslator struct {
+	count int
+}
+
+func (ct countTranslator) TranslateText(texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	return ct.TranslateTextContext(context.Background(), texts, targetLang, opts...)
+}
+
+func (ct countTranslator) TranslateTextContext(ctx context.Context, texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	return &Translations{Translations: make([]Translation, ct.count)}, nil
+}
+
+// Test CachedTranslator function TranslateText called twice with overlapping
+// texts
+// Function must send only texts not in cache and count hits and misses
+func Test_CachedTranslator_TranslateText(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+	ct := NewCachedTranslator(c, NewMemoryCache(10))
+
+	res, err := ct.TranslateText([]string{"apple", "pear"}, "DE")
+	assert.Nil(t, err)
+	assert.Equal(t, "APPLE", res.Translations[0].Text)
+
+	res, err = ct.TranslateText([]string{"pear", "plum", "apple"}, "DE")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"PEAR", "PLUM", "APPLE"}, []string{
+		res.Translations[0].Text, res.Translations[1].Text, res.Translations[2].Text,
+	})
+
+	_, err = ct.TranslateText([]string{"pear"}, "DE", WithFormality(FormalityMore))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []int{2, 1, 1}, requests)
+	assert.Equal(t, int64(2), ct.Hits())
+	assert.Equal(t, int64(4), ct.Misses())
+}
+
+// Test CachedTranslator function TranslateText with billed characters
+// Characters must be billed only for texts not in cache
+func Test_CachedTranslator_BilledCharacters(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()))
+	ct := NewCachedTranslator(c, NewMemoryCache(10))
+
+	res, err := ct.TranslateText([]string{"Hello"}, "DE", WithShowBilledCharacters(true))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, res.Translations[0].BilledCharacters)
+
+	res, err = ct.TranslateText([]string{"Hello", "World!"}, "DE", WithShowBilledCharacters(true))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, res.Translations[0].BilledCharacters)
+	assert.Equal(t, 6, res.Translations[1].BilledCharacters)
+
+	// Showing billed characters doesn't change translation
+	_, err = ct.TranslateText([]string{"World!"}, "DE")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), ct.Hits())
+	assert.Equal(t, 11, server.CharacterCount())
+}
+
+// Test CachedTranslator function TranslateText with a translator returning a
+// wrong number of translations
+// Function must return an error instead of panic or missing translations
+func Test_CachedTranslator_TranslationCount(t *testing.T) {
+	for _, count := range []int{0, 1, 3} {
+		ct := NewCachedTranslator(countTranslator{count: count}, NewMemoryCache(10))
+
+		_, err := ct.TranslateText([]string{"apple", "pear"}, "DE")
+		assert.EqualError(t, err, fmt.Sprintf("expected 2 translations, got %d", count))
+	}
+}
+
+// Test MemoryCache with more translations than capacity
+// Least recently used translation must be evicted
+func Test_MemoryCache_Eviction(t *testing.T) {
+	mc := NewMemoryCache(2)
+	mc.Set("a", Translation{Text: "A"})
+	mc.Set("b", Translation{Text: "B"})
+	mc.Get("a")
+	mc.Set("c", Translation{Text: "C"})
+
+	_, okA := mc.Get("a")
+	_, okB := mc.Get("b")
+	_, okC := mc.Get("c")
+	assert.True(t, okA)
+	assert.False(t, okB)
+	assert.True(t, okC)
+	assert.Equal(t, 2, mc.Len())
+}
+
+// Test FileCache between two instances on same directory
+// Translation must be read back from file
+func Test_FileCache_Persistence(t *testing.T) {
+	dir := t.TempDir()
+	fc, err := NewFileCache(dir)
+	assert.Nil(t, err)
+
+	translation := Translation{DetectedSourceLanguage: "EN", Text: "Hallo"}
+	fc.Set("../some key", translation)
+
+	fc, err = NewFileCache(dir)
+	assert.Nil(t, err)
+	res, ok := fc.Get("../some key")
+	assert.True(t, ok)
+	assert.Equal(t, translation, res)
+
+	_, ok = fc.Get("missing")
+	assert.False(t, ok)
+}
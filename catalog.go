@@ -0,0 +1,162 @@
+package deeplgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Default time languages and glossary language pairs are kept by a Catalog
+const defaultCatalogTTL = time.Hour
+
+// Delay before a Catalog retry a refresh which failed, or its TTL if shorter
+var catalogRetryInterval = 10 * time.Second
+
+// Catalog cache languages and glossary language pairs supported by DeepL so
+// input can be validated without a request each time. Lists are loaded on
+// first use and kept for a TTL, then the stale list is still returned while
+// it is refreshed in background. When refresh fail, stale list keep being
+// served. Loads of a list are deduplicated, callers waiting on the first load
+// share its result.
+type Catalog struct {
+	ttl       time.Duration
+	now       func() time.Time
+	languages *catalogEntry
+	pairs     *catalogEntry
+}
+
+// CatalogOption configure a Catalog created by NewCatalog
+type CatalogOption func(*Catalog)
+
+// WithCatalogTTL set time lists are served before being refreshed
+func WithCatalogTTL(ttl time.Duration) CatalogOption {
+	return func(c *Catalog) {
+		if ttl > 0 {
+			c.ttl = ttl
+		}
+	}
+}
+
+func NewCatalog(client *Client, opts ...CatalogOption) *Catalog {
+	c := &Catalog{
+		ttl: defaultCatalogTTL,
+		now: time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.languages = &catalogEntry{catalog: c, load: func(ctx context.Context) (interface{}, error) {
+		return client.GetLanguageSetContext(ctx)
+	}}
+	c.pairs = &catalogEntry{catalog: c, load: func(ctx context.Context) (interface{}, error) {
+		return client.GetGlossaryLanguagePairsContext(ctx)
+	}}
+
+	return c
+}
+
+func (c *Catalog) Languages() (*LanguageSet, error) {
+	return c.LanguagesContext(context.Background())
+}
+
+// LanguagesContext return source and target languages, ctx only bound the
+// wait of first load which is shared with other callers.
+func (c *Catalog) LanguagesContext(ctx context.Context) (*LanguageSet, error) {
+	value, err := c.languages.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*LanguageSet), nil
+}
+
+func (c *Catalog) GlossaryLanguagePairs() (*GlossaryLanguagePairs, error) {
+	return c.GlossaryLanguagePairsContext(context.Background())
+}
+
+// GlossaryLanguagePairsContext return language pairs supported by glossaries,
+// ctx only bound the wait of first load which is shared with other callers.
+func (c *Catalog) GlossaryLanguagePairsContext(ctx context.Context) (*GlossaryLanguagePairs, error) {
+	value, err := c.pairs.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*GlossaryLanguagePairs), nil
+}
+
+// catalogEntry hold a list of a Catalog and the load in flight if any
+type catalogEntry struct {
+	catalog *Catalog
+	load    func(ctx context.Context) (interface{}, error)
+
+	mu        sync.Mutex
+	value     interface{}
+	expiresAt time.Time
+	call      *catalogCall
+}
+
+// catalogCall is a load in flight, done is closed when it finish
+type catalogCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// get return value if loaded, refreshing it in background once expired, and
+// otherwise wait for a load.
+func (e *catalogEntry) get(ctx context.Context) (interface{}, error) {
+	e.mu.Lock()
+	if e.value != nil {
+		value := e.value
+		if !e.catalog.now().Before(e.expiresAt) && e.call == nil {
+			e.start()
+		}
+		e.mu.Unlock()
+		return value, nil
+	}
+	call := e.call
+	if call == nil {
+		call = e.start()
+	}
+	e.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// start run a load in background, e.mu must be held. Load is not bound to a
+// caller context as other callers may wait for it.
+func (e *catalogEntry) start() *catalogCall {
+	call := &catalogCall{done: make(chan struct{})}
+	e.call = call
+
+	go func() {
+		value, err := e.load(context.Background())
+
+		e.mu.Lock()
+		now := e.catalog.now()
+		if err == nil {
+			e.value = value
+			e.expiresAt = now.Add(e.catalog.ttl)
+		} else if e.value != nil {
+			retry := catalogRetryInterval
+			if retry > e.catalog.ttl {
+				retry = e.catalog.ttl
+			}
+			e.expiresAt = now.Add(retry)
+		}
+		e.call = nil
+		e.mu.Unlock()
+
+		call.value, call.err = value, err
+		close(call.done)
+	}()
+
+	return call
+}
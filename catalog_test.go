@@ -0,0 +1,128 @@
+package deeplgo
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a clock moved forward by tests
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newTestCatalog(server *deepltest.Server, clock *fakeClock) *Catalog {
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()), WithRetryPolicy(NoRetryPolicy()))
+	catalog := NewCatalog(c, WithCatalogTTL(time.Minute))
+	catalog.now = clock.Now
+
+	return catalog
+}
+
+// Test Catalog with concurrent first loads
+// Languages must be requested once and shared by all callers
+func Test_Catalog_SingleFlight(t *testing.T) {
+	server := deepltest.NewServer(deepltest.WithLatency(20 * time.Millisecond))
+	defer server.Close()
+	catalog := newTestCatalog(server, &fakeClock{now: time.Now()})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			languages, err := catalog.Languages()
+			assert.Nil(t, err)
+			assert.True(t, languages.IsValidTarget(LanguageGerman))
+		}()
+	}
+	wg.Wait()
+
+	// One request for source and one for target languages
+	assert.Equal(t, 2, server.Requests())
+
+	_, err := catalog.Languages()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, server.Requests())
+}
+
+// Test Catalog after TTL
+// Stale list must be returned at once and refreshed in background
+func Test_Catalog_Refresh(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	clock := &fakeClock{now: time.Now()}
+	catalog := newTestCatalog(server, clock)
+
+	first, err := catalog.GlossaryLanguagePairs()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, server.Requests())
+
+	clock.Add(2 * time.Minute)
+	stale, err := catalog.GlossaryLanguagePairs()
+	assert.Nil(t, err)
+	assert.Same(t, first, stale)
+
+	assert.Eventually(t, func() bool {
+		refreshed, err := catalog.GlossaryLanguagePairs()
+		return err == nil && refreshed != first
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 2, server.Requests())
+}
+
+// Test Catalog when refresh fail
+// Stale list must keep being served without error
+func Test_Catalog_RefreshFailure(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	clock := &fakeClock{now: time.Now()}
+	catalog := newTestCatalog(server, clock)
+
+	first, err := catalog.GlossaryLanguagePairs()
+	assert.Nil(t, err)
+
+	server.FailNext(503, 1)
+	clock.Add(2 * time.Minute)
+	_, err = catalog.GlossaryLanguagePairs()
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		return server.Requests() == 2
+	}, time.Second, time.Millisecond)
+
+	res, err := catalog.GlossaryLanguagePairs()
+	assert.Nil(t, err)
+	assert.Same(t, first, res)
+}
+
+// Test Catalog when first load fail
+// Error must be returned and next call must load again
+func Test_Catalog_FirstLoadFailure(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	catalog := newTestCatalog(server, &fakeClock{now: time.Now()})
+
+	server.FailNext(503, 1)
+	_, err := catalog.GlossaryLanguagePairs()
+	assert.ErrorIs(t, err, ErrResourceUnavailable)
+
+	_, err = catalog.GlossaryLanguagePairs()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,323 @@
+package deeplgo
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// textChunk is a part of a text too large for a single request, separator is
+// the whitespace following it in the original text which is kept as is. An
+// XML chunk is wrapped in its enclosing elements, openTags and closeTags are
+// the number of tags added before and after it to remove from its translation.
+type textChunk struct {
+	text      string
+	separator string
+	openTags  int
+	closeTags int
+}
+
+// Boundaries where a text can be split, from the most to the least preferred
+var chunkBoundaries = []*regexp.Regexp{
+	regexp.MustCompile(`\s*\n\s*\n\s*`),                // paragraph
+	regexp.MustCompile(`[.!?…]["'”’)\]]*\s+|[。！？]\s*`), // sentence
+	regexp.MustCompile(`\s+`),                          // word
+}
+
+// splitText split text into chunks each fitting in maxSize bytes once encoded
+// in a request, at paragraph then sentence then word boundaries. With tag
+// handling text is never split inside a tag, and with XML each chunk is
+// wrapped in the elements enclosing it so it stays well-formed.
+func splitText(text string, maxSize int, tagHandling TagHandling) ([]textChunk, error) {
+	switch tagHandling {
+	case TagHandlingXML:
+		return splitXML(text, maxSize)
+	case TagHandlingHTML:
+		return splitAtLevel(text, 0, maxSize, 0, htmlTextRanges(text))
+	}
+
+	return splitAtLevel(text, 0, maxSize, 0, func(start, end int) bool { return true })
+}
+
+// splitAtLevel split text, found at offset of original text, on boundaries
+// of chunkBoundaries[level] then on next levels for parts still too large.
+func splitAtLevel(text string, offset int, maxSize int, level int, allowed func(start, end int) bool) ([]textChunk, error) {
+	if size, err := textRequestSize(text); err != nil {
+		return nil, err
+	} else if size <= maxSize {
+		return []textChunk{{text: text}}, nil
+	}
+	if level == len(chunkBoundaries) {
+		return nil, fmt.Errorf("text can't be split under request size: %w", ErrRequestSizeExceedsLimit)
+	}
+
+	// Cut text on every boundary of this level, separator is the trailing
+	// whitespace of boundary
+	parts := []textChunk{}
+	start := 0
+	for _, loc := range chunkBoundaries[level].FindAllStringIndex(text, -1) {
+		if loc[0] == 0 || loc[1] == len(text) || !allowed(offset+loc[0], offset+loc[1]) {
+			continue
+		}
+		sepStart := loc[0] + len(strings.TrimRightFunc(text[loc[0]:loc[1]], unicode.IsSpace))
+		parts = append(parts, textChunk{text: text[start:sepStart], separator: text[sepStart:loc[1]]})
+		start = loc[1]
+	}
+	parts = append(parts, textChunk{text: text[start:]})
+
+	if len(parts) == 1 {
+		return splitAtLevel(text, offset, maxSize, level+1, allowed)
+	}
+
+	// Merge consecutive parts while they fit, parts too large are split on
+	// next level. JSON escaping is done per character so size of merged parts
+	// is the sum of their sizes, without quotes and separator counted twice.
+	emptySize, err := textRequestSize("")
+	if err != nil {
+		return nil, err
+	}
+	chunks := []textChunk{}
+	partOffset := offset
+	var current *textChunk
+	currentStart, currentSize := 0, 0
+	for _, part := range parts {
+		start := partOffset - offset
+		if current != nil {
+			size, err := textRequestSize(current.separator + part.text)
+			if err != nil {
+				return nil, err
+			}
+			if currentSize+size-emptySize <= maxSize {
+				current.text = text[currentStart : start+len(part.text)]
+				current.separator = part.separator
+				currentSize += size - emptySize
+				partOffset += len(part.text) + len(part.separator)
+				continue
+			}
+			chunks = append(chunks, *current)
+			current = nil
+		}
+
+		subChunks, err := splitAtLevel(part.text, partOffset, maxSize, level+1, allowed)
+		if err != nil {
+			return nil, err
+		}
+		subChunks[len(subChunks)-1].separator = part.separator
+		chunks = append(chunks, subChunks[:len(subChunks)-1]...)
+		last := subChunks[len(subChunks)-1]
+		current = &last
+		currentStart = start + len(part.text) - len(last.text)
+		if currentSize, err = textRequestSize(last.text); err != nil {
+			return nil, err
+		}
+		partOffset += len(part.text) + len(part.separator)
+	}
+	chunks = append(chunks, *current)
+
+	return chunks, nil
+}
+
+// htmlTextRanges return a function telling if text can be split between
+// start and end, which is when there is no tag between them.
+func htmlTextRanges(text string) func(start, end int) bool {
+	inTag := make([]bool, len(text))
+	open := false
+	for i := 0; i < len(text); i++ {
+		if text[i] == '<' {
+			open = true
+		}
+		inTag[i] = open
+		if text[i] == '>' {
+			open = false
+		}
+	}
+
+	return func(start, end int) bool {
+		for i := start; i < end; i++ {
+			if inTag[i] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// xmlTextRange is a byte range of text content in a XML document, with start
+// tags of elements enclosing it.
+type xmlTextRange struct {
+	start     int
+	end       int
+	enclosing []string
+}
+
+// splitXML split a XML document between or inside elements at any depth, each
+// chunk is wrapped in start tags of elements open at its start and end tags
+// of elements open at its end.
+func splitXML(text string, maxSize int) ([]textChunk, error) {
+	if size, err := textRequestSize(text); err != nil {
+		return nil, err
+	} else if size <= maxSize {
+		return []textChunk{{text: text}}, nil
+	}
+
+	ranges, err := xmlTextRanges(text)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep room for the largest wrapping of a chunk
+	emptySize, err := textRequestSize("")
+	if err != nil {
+		return nil, err
+	}
+	wrapSize := 0
+	for _, r := range ranges {
+		size, err := textRequestSize(xmlStartTags(r.enclosing) + xmlEndTags(r.enclosing))
+		if err != nil {
+			return nil, err
+		}
+		if size-emptySize > wrapSize {
+			wrapSize = size - emptySize
+		}
+	}
+
+	// rangeAt return range containing offsets from start to end, ranges are
+	// sorted and never adjacent
+	rangeAt := func(start, end int) *xmlTextRange {
+		i := sort.Search(len(ranges), func(i int) bool { return ranges[i].end >= end })
+		if i < len(ranges) && ranges[i].start <= start {
+			return &ranges[i]
+		}
+		return nil
+	}
+	chunks, err := splitAtLevel(text, 0, maxSize-wrapSize, 0, func(start, end int) bool {
+		return rangeAt(start, end) != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	offset := 0
+	for i := range chunks {
+		var open, closed []string
+		if i > 0 {
+			open = rangeAt(offset, offset).enclosing
+		}
+		offset += len(chunks[i].text)
+		if i < len(chunks)-1 {
+			closed = rangeAt(offset, offset).enclosing
+		}
+		offset += len(chunks[i].separator)
+
+		chunks[i].text = xmlStartTags(open) + chunks[i].text + xmlEndTags(closed)
+		chunks[i].openTags = len(open)
+		chunks[i].closeTags = len(closed)
+	}
+
+	return chunks, nil
+}
+
+// xmlTextRanges return ranges of text content of a XML document, CDATA
+// sections are excluded as they can't be split.
+func xmlTextRanges(text string) ([]xmlTextRange, error) {
+	decoder := xml.NewDecoder(strings.NewReader(text))
+	decoder.Strict = true
+	decoder.Entity = xml.HTMLEntity
+
+	ranges := []xmlTextRange{}
+	enclosing := []string{}
+	for {
+		start := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return ranges, nil
+		} else if err != nil {
+			return nil, err
+		}
+		end := int(decoder.InputOffset())
+
+		switch token.(type) {
+		case xml.StartElement:
+			enclosing = append(enclosing, text[start:end])
+		case xml.EndElement:
+			enclosing = enclosing[:len(enclosing)-1]
+		case xml.CharData:
+			if !strings.HasPrefix(text[start:end], "<![CDATA[") {
+				ranges = append(ranges, xmlTextRange{start: start, end: end, enclosing: append([]string{}, enclosing...)})
+			}
+		}
+	}
+}
+
+// xmlStartTags join start tags as found in document
+func xmlStartTags(tags []string) string {
+	return strings.Join(tags, "")
+}
+
+// xmlEndTags return end tags closing start tags, in reverse order
+func xmlEndTags(tags []string) string {
+	b := strings.Builder{}
+	for i := len(tags) - 1; i >= 0; i-- {
+		name := strings.TrimPrefix(tags[i], "<")
+		if end := strings.IndexAny(name, " \t\r\n/>"); end >= 0 {
+			name = name[:end]
+		}
+		b.WriteString("</" + name + ">")
+	}
+
+	return b.String()
+}
+
+// unwrapXMLChunk remove from translation of a chunk the first openTags start
+// tags and the last closeTags end tags added by splitXML
+func unwrapXMLChunk(text string, openTags int, closeTags int) (string, error) {
+	if openTags == 0 && closeTags == 0 {
+		return text, nil
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(text))
+	decoder.Strict = true
+	decoder.Entity = xml.HTMLEntity
+
+	starts := [][2]int{}
+	ends := [][2]int{}
+	for {
+		start := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		end := int(decoder.InputOffset())
+
+		switch token.(type) {
+		case xml.StartElement:
+			starts = append(starts, [2]int{start, end})
+		case xml.EndElement:
+			if end > start {
+				ends = append(ends, [2]int{start, end})
+			}
+		}
+	}
+	if len(starts) < openTags || len(ends) < closeTags {
+		return "", fmt.Errorf("translation of chunk is missing enclosing tags")
+	}
+
+	// Wrapping tags come before and after any content of chunk
+	removed := append(starts[:openTags:openTags], ends[len(ends)-closeTags:]...)
+	b := strings.Builder{}
+	offset := 0
+	for _, r := range removed {
+		b.WriteString(text[offset:r[0]])
+		offset = r[1]
+	}
+	b.WriteString(text[offset:])
+
+	return b.String(), nil
+}
@@ -0,0 +1,121 @@
+package deeplgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func joinChunks(chunks []textChunk) string {
+	b := strings.Builder{}
+	for _, chunk := range chunks {
+		b.WriteString(chunk.text + chunk.separator)
+	}
+	return b.String()
+}
+
+// Test function splitText on text smaller than max size
+// Function must return text as a single chunk
+func Test_SplitText_Small(t *testing.T) {
+	chunks, err := splitText("Hello world.", 100, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []textChunk{{text: "Hello world."}}, chunks)
+}
+
+// Test function splitText on paragraphs
+// Function must split on blank lines and keep them as separators
+func Test_SplitText_Paragraphs(t *testing.T) {
+	text := "First paragraph. Still first.\n\n  Second paragraph.\r\n\r\nThird one."
+	chunks, err := splitText(text, 35, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []textChunk{
+		{text: "First paragraph. Still first.", separator: "\n\n  "},
+		{text: "Second paragraph.", separator: "\r\n\r\n"},
+		{text: "Third one."},
+	}, chunks)
+	assert.Equal(t, text, joinChunks(chunks))
+}
+
+// Test function splitText on a paragraph larger than max size
+// Function must split paragraph on sentences and merge small ones
+func Test_SplitText_Sentences(t *testing.T) {
+	text := "One. Two! Three? Four is longer.\n\nFive."
+	chunks, err := splitText(text, 20, "")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []textChunk{
+		{text: "One. Two! Three?", separator: " "},
+		{text: "Four is longer.", separator: "\n\n"},
+		{text: "Five."},
+	}, chunks)
+	assert.Equal(t, text, joinChunks(chunks))
+}
+
+// Test function splitText on a word larger than max size
+// Function must return error request size exceeds limit
+func Test_SplitText_TooLarge(t *testing.T) {
+	_, err := splitText(strings.Repeat("a", 100), 50, "")
+
+	assert.ErrorIs(t, err, ErrRequestSizeExceedsLimit)
+}
+
+// Test function splitText with HTML tag handling
+// Function must not split inside a tag
+func Test_SplitText_HTML(t *testing.T) {
+	text := `<p>Hello.</p> <a title="Not. Here.">World.</a>`
+	chunks, err := splitText(text, 45, TagHandlingHTML)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []textChunk{
+		{text: `<p>Hello.</p>`, separator: " "},
+		{text: `<a title="Not. Here.">World.</a>`},
+	}, chunks)
+}
+
+// Test function splitText with XML tag handling
+// Function must split only outside tags and wrap each chunk in its enclosing
+// elements so it stays well-formed
+func Test_SplitText_XML(t *testing.T) {
+	text := "<p>First. Second.</p>\n\n<p>Third. Fourth.</p>"
+	chunks, err := splitText(text, 32, TagHandlingXML)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []textChunk{
+		{text: "<p>First. Second.</p>", separator: "\n\n"},
+		{text: "<p>Third. Fourth.</p>"},
+	}, chunks)
+
+	text = `<topic id="t1"><body><p>First. Second.</p> <p>Third.<br/> Fourth.</p></body></topic>`
+	chunks, err = splitText(text, 80, TagHandlingXML)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []textChunk{
+		{text: `<topic id="t1"><body><p>First.</p></body></topic>`, separator: " ", closeTags: 3},
+		{text: `<topic id="t1"><body><p>Second.</p> <p>Third.<br/></p></body></topic>`, separator: " ", openTags: 3, closeTags: 3},
+		{text: `<topic id="t1"><body><p>Fourth.</p></body></topic>`, openTags: 3},
+	}, chunks)
+	for _, chunk := range chunks {
+		assert.Nil(t, checkWellFormedXML(chunk.text))
+	}
+
+	_, err = splitText("<p><![CDATA[First. Second. Third. Fourth.]]></p>", 30, TagHandlingXML)
+	assert.ErrorIs(t, err, ErrRequestSizeExceedsLimit)
+}
+
+// Test function unwrapXMLChunk on translations of chunks
+// Function must remove only tags added by splitXML and keep translated text
+func Test_UnwrapXMLChunk(t *testing.T) {
+	text, err := unwrapXMLChunk(`[DE] <topic id="t1"><body><p>Dritte.<br/></p></body></topic>`, 2, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, `[DE] <p>Dritte.<br/>`, text)
+
+	text, err = unwrapXMLChunk("Hallo", 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "Hallo", text)
+
+	_, err = unwrapXMLChunk("<p>Hallo</p>", 2, 2)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,74 @@
+package deeplgo
+
+import (
+	"net/http"
+	"time"
+)
+
+// ClientOption configure a Client created by NewClient
+type ClientOption func(*Client)
+
+// WithHTTPClient send requests with httpClient instead of default one, later
+// options like WithTimeout or WithTransport apply on a copy of it.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient.client = httpClient
+	}
+}
+
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		client := *c.httpClient.client
+		client.Timeout = timeout
+		c.httpClient.client = &client
+	}
+}
+
+// WithTransport send requests through transport, for proxy or TLS setup
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		client := *c.httpClient.client
+		client.Transport = transport
+		c.httpClient.client = &client
+	}
+}
+
+// WithServerURL override server URL taken from environment or API key type,
+// like SetBaseUrl.
+func WithServerURL(serverURL string) ClientOption {
+	return func(c *Client) {
+		c.SetBaseUrl(serverURL)
+	}
+}
+
+// WithUserAgent set header `User-Agent` of every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.httpClient.userAgent = userAgent
+	}
+}
+
+func WithRetryPolicy(retryPolicy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.httpClient.retryPolicy = retryPolicy
+	}
+}
+
+// WithPreflightValidation check languages, formality and glossary language
+// pairs of translation and glossary requests against lists supported by
+// DeepL before sending them, so an invalid request is never billed. Lists are
+// kept in a Catalog configured by opts.
+func WithPreflightValidation(opts ...CatalogOption) ClientOption {
+	return func(c *Client) {
+		c.catalog = NewCatalog(c, opts...)
+	}
+}
+
+// WithBatchConcurrency set number of requests TranslateBatch send in parallel
+func WithBatchConcurrency(concurrency int) ClientOption {
+	return func(c *Client) {
+		if concurrency > 0 {
+			c.batchConcurrency = concurrency
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package deeplgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// Test NewClient with options
+// Client must send requests to server URL through transport with user agent
+func Test_NewClient_Options(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.Header.Get("User-Agent"), "my-app/1.0")
+			w.Write([]byte(`{"character_count":1,"character_limit":2}`))
+		},
+	))
+
+	defer server.Close()
+
+	transport := &countingTransport{}
+	c := NewClient("NO_API_KEY",
+		WithServerURL(server.URL),
+		WithTransport(transport),
+		WithUserAgent("my-app/1.0"),
+		WithTimeout(5*time.Second),
+		WithRetryPolicy(NoRetryPolicy()),
+	)
+
+	res, err := c.GetUsage()
+
+	assert.Nil(t, err)
+	assert.Equal(t, &Usage{CharacterCount: 1, CharacterLimit: 2}, res)
+	assert.Equal(t, server.URL, c.GetBaseUrl())
+	assert.Equal(t, 1, transport.calls)
+	assert.Equal(t, 5*time.Second, c.httpClient.client.Timeout)
+	assert.Equal(t, NoRetryPolicy(), c.httpClient.GetRetryPolicy())
+}
+
+// Test NewClient with an http.Client followed by WithTimeout
+// Given http.Client must not be modified
+func Test_NewClient_WithHTTPClientNotModified(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+	c := NewClient("NO_API_KEY",
+		WithHTTPClient(httpClient),
+		WithTimeout(time.Minute),
+	)
+
+	assert.Equal(t, time.Second, httpClient.Timeout)
+	assert.Equal(t, time.Minute, c.httpClient.client.Timeout)
+}
+
+// Test WithServerURL with a server URL ending with API version
+// Version must be removed as endpoints already start with it
+func Test_NewClient_ServerURLWithVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, usageEndpoint)
+			w.Write([]byte(`{"character_count":1,"character_limit":2}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL+"/v2/"))
+
+	_, err := c.GetUsage()
+
+	assert.Nil(t, err)
+	assert.Equal(t, server.URL, c.GetBaseUrl())
+}
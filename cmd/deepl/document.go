@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+)
+
+const documentUsage = `Usage: deepl document translate [--json] --target LANG [options] FILE
+
+Translate FILE and write translated document to --output, by default FILE
+with target language added before its extension.
+
+`
+
+func (c *cli) document(args []string) error {
+	if len(args) == 0 || args[0] != "translate" {
+		fmt.Fprint(c.stderr, documentUsage)
+		return errUsage
+	}
+
+	fs := c.newFlagSet("document translate", documentUsage)
+	targetLang := fs.String("target", "", "target language, required")
+	sourceLang := fs.String("source", "", "source language, detected if not set")
+	formality := fs.String("formality", "", "formality: default, more, less, prefer_more or prefer_less")
+	glossaryID := fs.String("glossary", "", "ID of glossary to use, source language is required")
+	output := fs.String("output", "", "file of translated document")
+	if err := fs.Parse(args[1:]); err != nil {
+		return c.parseError(err)
+	}
+	if *targetLang == "" || fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	input := fs.Arg(0)
+	if *output == "" {
+		ext := filepath.Ext(input)
+		*output = strings.TrimSuffix(input, ext) + "_" + strings.ToLower(*targetLang) + ext
+	}
+
+	opts := []deeplgo.DocumentOption{}
+	if *sourceLang != "" {
+		opts = append(opts, deeplgo.WithDocumentSourceLang(*sourceLang))
+	}
+	if *formality != "" {
+		opts = append(opts, deeplgo.WithDocumentFormality(deeplgo.Formality(*formality)))
+	}
+	if *glossaryID != "" {
+		opts = append(opts, deeplgo.WithDocumentGlossaryID(deeplgo.GlossaryID(*glossaryID)))
+	}
+
+	in, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	// Translated document is written to a temporary file renamed on success,
+	// so an existing output is kept when translation fail
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(*output); err == nil {
+		mode = info.Mode().Perm()
+	}
+	out, err := os.CreateTemp(filepath.Dir(*output), "."+filepath.Base(*output)+".*")
+	if err != nil {
+		return err
+	}
+
+	status, err := c.client.TranslateDocument(in, filepath.Base(input), out, *targetLang, opts...)
+	if err == nil {
+		err = out.Chmod(mode)
+	}
+	if errClose := out.Close(); err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = os.Rename(out.Name(), *output)
+	}
+	if err != nil {
+		os.Remove(out.Name())
+		return err
+	}
+
+	return c.print(status, func(w io.Writer) {
+		fmt.Fprintf(w, "%s (%d characters billed)\n", *output, status.BilledCharacters)
+	})
+}
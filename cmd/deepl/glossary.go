@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+)
+
+const glossaryUsage = `Usage: deepl glossary <command> [arguments]
+
+Commands:
+  create --name NAME --source LANG --target LANG FILE
+                    create a glossary from a TSV or CSV file, - for stdin
+  sync --name NAME --source LANG --target LANG FILE
+                    recreate glossary named NAME only if FILE changed
+  list              list glossaries
+  get ID            print a glossary
+  delete ID         delete a glossary
+  entries [--format tsv|csv] ID
+                    print entries of a glossary
+`
+
+func (c *cli) glossary(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, glossaryUsage)
+		return errUsage
+	}
+
+	switch args[0] {
+	case "create":
+		return c.glossaryCreate(args[1:])
+	case "sync":
+		return c.glossarySync(args[1:])
+	case "list":
+		return c.glossaryList(args[1:])
+	case "get", "delete", "entries":
+		return c.glossaryByID(args[0], args[1:])
+	case "-h", "-help", "--help":
+		fmt.Fprint(c.stderr, glossaryUsage)
+		return nil
+	}
+
+	fmt.Fprintf(c.stderr, "deepl: unknown glossary command %q\n\n%s", args[0], glossaryUsage)
+	return errUsage
+}
+
+func (c *cli) glossaryCreate(args []string) error {
+	fs := c.newFlagSet("glossary create", "Usage: deepl glossary create [--json] --name NAME --source LANG --target LANG FILE\n")
+	name := fs.String("name", "", "name of glossary, required")
+	sourceLang := fs.String("source", "", "source language, required")
+	targetLang := fs.String("target", "", "target language, required")
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+	if *name == "" || *sourceLang == "" || *targetLang == "" || fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	entries, err := c.readEntries(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	glossary, err := c.client.CreateGlossary(*name, *sourceLang, *targetLang, entries)
+	if err != nil {
+		return err
+	}
+
+	return c.print(glossary, func(w io.Writer) {
+		fmt.Fprintln(w, glossary.GlossaryID)
+	})
+}
+
+func (c *cli) glossarySync(args []string) error {
+	fs := c.newFlagSet("glossary sync", "Usage: deepl glossary sync [--json] --name NAME --source LANG --target LANG FILE\n")
+	name := fs.String("name", "", "name of glossary, required")
+	sourceLang := fs.String("source", "", "source language, required")
+	targetLang := fs.String("target", "", "target language, required")
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+	if *name == "" || *sourceLang == "" || *targetLang == "" || fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	entries, err := c.readEntries(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	pair := deeplgo.GlossaryLanguagePair{SourceLang: *sourceLang, TargetLang: *targetLang}
+	res, err := c.client.SyncGlossary(*name, pair, entries)
+	if err != nil {
+		return err
+	}
+
+	return c.print(res, func(w io.Writer) {
+		if !res.Created {
+			fmt.Fprintf(w, "%s unchanged\n", res.GlossaryID)
+			return
+		}
+		fmt.Fprintf(w, "%s created: %d added, %d removed, %d changed\n",
+			res.GlossaryID, len(res.Diff.Added), len(res.Diff.Removed), len(res.Diff.Changed))
+		for _, glossaryID := range res.Deleted {
+			fmt.Fprintf(w, "%s deleted\n", glossaryID)
+		}
+	})
+}
+
+func (c *cli) glossaryList(args []string) error {
+	fs := c.newFlagSet("glossary list", "Usage: deepl glossary list [--json]\n")
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+
+	glossaries, err := c.client.ListGlossaries()
+	if err != nil {
+		return err
+	}
+
+	return c.print(glossaries, func(w io.Writer) {
+		for _, glossary := range glossaries.Glossaries {
+			printGlossary(w, glossary)
+		}
+	})
+}
+
+// glossaryByID run get, delete or entries command on glossary given as
+// argument.
+func (c *cli) glossaryByID(command string, args []string) error {
+	fs := c.newFlagSet("glossary "+command, fmt.Sprintf("Usage: deepl glossary %s [--json] ID\n", command))
+	format := string(deeplgo.EntriesFormatTSV)
+	if command == "entries" {
+		fs.StringVar(&format, "format", format, "format of entries, tsv or csv")
+	}
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	glossaryID := deeplgo.GlossaryID(fs.Arg(0))
+
+	switch command {
+	case "get":
+		glossary, err := c.client.GetGlossary(glossaryID)
+		if err != nil {
+			return err
+		}
+		return c.print(glossary, func(w io.Writer) {
+			printGlossary(w, *glossary)
+		})
+	case "delete":
+		return c.client.DeleteGlossary(glossaryID)
+	}
+
+	entries, err := c.client.GetGlossaryEntries(glossaryID)
+	if err != nil {
+		return err
+	}
+	encoded, err := entries.Encode(deeplgo.EntriesFormat(format))
+	if err != nil {
+		return err
+	}
+	return c.print(entries, func(w io.Writer) {
+		fmt.Fprint(w, encoded)
+	})
+}
+
+func printGlossary(w io.Writer, glossary deeplgo.Glossary) {
+	fmt.Fprintf(w, "%s\t%s\t%s->%s\t%d entries\n", glossary.GlossaryID, glossary.Name, glossary.SourceLang, glossary.TargetLang, glossary.EntryCount)
+}
+
+// readEntries read glossary entries from file, as CSV when its extension is
+// .csv and as TSV otherwise.
+func (c *cli) readEntries(file string) (deeplgo.GlossaryEntries, error) {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(c.stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	format := deeplgo.EntriesFormatTSV
+	if strings.EqualFold(filepath.Ext(file), ".csv") {
+		format = deeplgo.EntriesFormatCSV
+	}
+
+	return deeplgo.ParseGlossaryEntries(string(data), format)
+}
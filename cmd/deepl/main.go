@@ -0,0 +1,202 @@
+// Command deepl is a command-line client of DeepL API built on deeplgo.
+//
+// API key is read from environment variable DEEPL_AUTH_KEY and server URL
+// from DEEPL_SERVER_URL when set. Every command accept --json to print the
+// API response as JSON, for example to pipe it into jq.
+//
+//	deepl translate --target DE "Hello world"
+//	echo "Hello world" | deepl translate --target DE
+//	deepl usage
+//	deepl languages --type target
+//	deepl glossary-pairs
+//	deepl glossary create --name my-glossary --source EN --target DE entries.tsv
+//	deepl glossary sync --name my-glossary --source EN --target DE entries.csv
+//	deepl glossary list|get|delete|entries [ID]
+//	deepl document translate --target DE --output report_de.docx report.docx
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+)
+
+const usageText = `Usage: deepl [--json] <command> [arguments]
+
+Commands:
+  translate         translate texts given as arguments or read from stdin
+  usage             print characters translated in current billing period
+  languages         print source or target languages
+  glossary-pairs    print language pairs supported by glossaries
+  glossary          create, sync, list, get, delete glossaries or print entries
+  document          translate a document
+
+Environment:
+  DEEPL_AUTH_KEY    API key, required
+  DEEPL_SERVER_URL  server URL, default depends on API key
+`
+
+// errUsage is returned when command line is invalid, usage is already printed
+var errUsage = errors.New("invalid usage")
+
+type cli struct {
+	stdin      io.Reader
+	stdout     io.Writer
+	stderr     io.Writer
+	jsonOutput bool
+	client     *deeplgo.Client
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run execute command line args and return exit status
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr}
+
+	err := c.run(args)
+	if errors.Is(err, errUsage) {
+		return 2
+	} else if err != nil {
+		fmt.Fprintf(stderr, "deepl: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (c *cli) run(args []string) error {
+	fs := c.newFlagSet("deepl", usageText)
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprint(c.stderr, usageText)
+		return errUsage
+	}
+
+	commands := map[string]func([]string) error{
+		"translate":      c.translate,
+		"usage":          c.usage,
+		"languages":      c.languages,
+		"glossary-pairs": c.glossaryPairs,
+		"glossary":       c.glossary,
+		"document":       c.document,
+	}
+	command, ok := commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(c.stderr, "deepl: unknown command %q\n\n%s", fs.Arg(0), usageText)
+		return errUsage
+	}
+
+	authKey := os.Getenv("DEEPL_AUTH_KEY")
+	if authKey == "" {
+		return errors.New("environment variable DEEPL_AUTH_KEY is not set")
+	}
+	c.client = deeplgo.NewClient(authKey)
+
+	return command(fs.Args()[1:])
+}
+
+// newFlagSet create flags of a command with --json, usage is printed on error
+func (c *cli) newFlagSet(name string, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprint(c.stderr, usage)
+		fs.PrintDefaults()
+	}
+	fs.BoolVar(&c.jsonOutput, "json", c.jsonOutput, "print response as JSON")
+
+	return fs
+}
+
+// parseError convert an error of flag parsing, help is not an error
+func (c *cli) parseError(err error) error {
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+
+	return errUsage
+}
+
+// print write data as JSON with --json, otherwise call text to write it in a
+// human readable format.
+func (c *cli) print(data interface{}, text func(w io.Writer)) error {
+	if c.jsonOutput {
+		encoder := json.NewEncoder(c.stdout)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(data)
+	}
+
+	text(c.stdout)
+	return nil
+}
+
+func (c *cli) usage(args []string) error {
+	fs := c.newFlagSet("usage", "Usage: deepl usage [--json]\n")
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+
+	usage, err := c.client.GetUsage()
+	if err != nil {
+		return err
+	}
+
+	return c.print(usage, func(w io.Writer) {
+		fmt.Fprintf(w, "%d of %d characters\n", usage.CharacterCount, usage.CharacterLimit)
+	})
+}
+
+func (c *cli) languages(args []string) error {
+	fs := c.newFlagSet("languages", "Usage: deepl languages [--json] [--type source|target]\n")
+	languageType := fs.String("type", string(deeplgo.Source), "type of languages, source or target")
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+	if *languageType != string(deeplgo.Source) && *languageType != string(deeplgo.Target) {
+		fmt.Fprintf(c.stderr, "deepl: invalid language type %q, expected source or target\n", *languageType)
+		return errUsage
+	}
+
+	languages, err := c.client.GetLanguages(deeplgo.LanguageType(*languageType))
+	if err != nil {
+		return err
+	}
+
+	return c.print(languages, func(w io.Writer) {
+		for _, language := range *languages {
+			formality := ""
+			if language.SupportsFormality {
+				formality = "\tformality"
+			}
+			fmt.Fprintf(w, "%s\t%s%s\n", language.Language, language.Name, formality)
+		}
+	})
+}
+
+func (c *cli) glossaryPairs(args []string) error {
+	fs := c.newFlagSet("glossary-pairs", "Usage: deepl glossary-pairs [--json]\n")
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+
+	pairs, err := c.client.GetGlossaryLanguagePairs()
+	if err != nil {
+		return err
+	}
+
+	return c.print(pairs, func(w io.Writer) {
+		for _, pair := range pairs.SupportedLanguages {
+			fmt.Fprintf(w, "%s\t%s\n", pair.SourceLang, pair.TargetLang)
+		}
+	})
+}
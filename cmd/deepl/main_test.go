@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+// runTest run command line args against a fake server and return exit status
+// with stdout and stderr.
+func runTest(t *testing.T, server *deepltest.Server, stdin string, args ...string) (int, string, string) {
+	t.Setenv("DEEPL_AUTH_KEY", server.AuthKey())
+	t.Setenv("DEEPL_SERVER_URL", server.URL())
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	status := run(args, strings.NewReader(stdin), stdout, stderr)
+
+	return status, stdout.String(), stderr.String()
+}
+
+// Test translate command with texts as arguments and from stdin
+func Test_Translate(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+
+	status, stdout, _ := runTest(t, server, "", "translate", "--target", "DE", "Hello", "World")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "[DE] Hello\n[DE] World\n", stdout)
+
+	status, stdout, _ = runTest(t, server, "Hello from stdin\n", "translate", "--target", "FR")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "[FR] Hello from stdin\n", stdout)
+}
+
+// Test --json option before and after command
+// Output must be the API response
+func Test_JSONOutput(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+
+	for _, args := range [][]string{
+		{"--json", "translate", "--target", "DE", "Hello"},
+		{"translate", "--json", "--target", "DE", "Hello"},
+	} {
+		status, stdout, _ := runTest(t, server, "", args...)
+		assert.Equal(t, 0, status)
+
+		translations := deeplgo.Translations{}
+		assert.Nil(t, json.Unmarshal([]byte(stdout), &translations))
+		assert.Equal(t, "[DE] Hello", translations.Translations[0].Text)
+	}
+}
+
+// Test usage, languages and glossary-pairs commands
+func Test_Information(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	server.SetCharacterCount(42)
+
+	status, stdout, _ := runTest(t, server, "", "usage")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "42 of 500000 characters\n", stdout)
+
+	status, stdout, _ = runTest(t, server, "", "languages", "--type", "target")
+	assert.Equal(t, 0, status)
+	assert.Contains(t, stdout, "DE\tGerman\tformality\n")
+	assert.Contains(t, stdout, "EN-GB\tEnglish (British)\n")
+
+	status, stdout, _ = runTest(t, server, "", "glossary-pairs")
+	assert.Equal(t, 0, status)
+	assert.Contains(t, stdout, "en\tde\n")
+}
+
+// Test glossary commands from creation to deletion
+func Test_Glossary(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+
+	status, stdout, stderr := runTest(t, server, "hello\thallo\nworld\twelt\n", "glossary", "create", "--name", "test", "--source", "EN", "--target", "DE", "-")
+	assert.Equal(t, 0, status, stderr)
+	glossaryID := strings.TrimSpace(stdout)
+
+	status, stdout, _ = runTest(t, server, "", "glossary", "list")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, glossaryID+"\ttest\ten->de\t2 entries\n", stdout)
+
+	status, stdout, _ = runTest(t, server, "", "glossary", "entries", glossaryID)
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "hello\thallo\nworld\twelt\n", stdout)
+
+	status, _, _ = runTest(t, server, "", "glossary", "delete", glossaryID)
+	assert.Equal(t, 0, status)
+
+	status, _, stderr = runTest(t, server, "", "glossary", "get", glossaryID)
+	assert.Equal(t, 1, status)
+	assert.Contains(t, stderr, deeplgo.ErrRequestResourceNotFound.Error())
+}
+
+// Test document translate command
+// Translated document must be written next to original one by default
+func Test_DocumentTranslate(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+
+	input := filepath.Join(t.TempDir(), "report.txt")
+	assert.Nil(t, os.WriteFile(input, []byte("Hello"), 0o644))
+
+	status, _, stderr := runTest(t, server, "", "document", "translate", "--target", "DE", input)
+	assert.Equal(t, 0, status, stderr)
+
+	data, err := os.ReadFile(filepath.Join(filepath.Dir(input), "report_de.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, deepltest.FakeTranslation("Hello", "DE"), string(data))
+}
+
+// Test document translate command failing
+// Existing output file must be kept and no temporary file left
+func Test_DocumentTranslateError(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "report.txt")
+	output := filepath.Join(dir, "existing.txt")
+	assert.Nil(t, os.WriteFile(input, []byte("Hello"), 0o644))
+	assert.Nil(t, os.WriteFile(output, []byte("Keep me"), 0o644))
+
+	server.FailNext(456, 1)
+	status, _, stderr := runTest(t, server, "", "document", "translate", "--target", "DE", "--output", output, input)
+	assert.Equal(t, 1, status)
+	assert.Contains(t, stderr, deeplgo.ErrQuotaExceeded.Error())
+
+	data, err := os.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Equal(t, "Keep me", string(data))
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+
+	status, _, stderr = runTest(t, server, "", "document", "translate", "--target", "DE", "--output", output, input)
+	assert.Equal(t, 0, status, stderr)
+	data, err = os.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Equal(t, deepltest.FakeTranslation("Hello", "DE"), string(data))
+}
+
+// Test invalid command lines and missing API key
+func Test_Errors(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+
+	status, _, _ := runTest(t, server, "")
+	assert.Equal(t, 2, status)
+
+	status, _, _ = runTest(t, server, "", "unknown")
+	assert.Equal(t, 2, status)
+
+	status, _, _ = runTest(t, server, "", "translate", "Hello")
+	assert.Equal(t, 2, status)
+
+	status, _, _ = runTest(t, server, "", "languages", "--type", "other")
+	assert.Equal(t, 2, status)
+
+	t.Setenv("DEEPL_AUTH_KEY", "")
+	stderr := &bytes.Buffer{}
+	status = run([]string{"usage"}, strings.NewReader(""), &bytes.Buffer{}, stderr)
+	assert.Equal(t, 1, status)
+	assert.Contains(t, stderr.String(), "DEEPL_AUTH_KEY")
+}
+
+// Test glossary sync command with a CSV file
+// Glossary must be created then left unchanged
+func Test_GlossarySync(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "terms.csv")
+	assert.Nil(t, os.WriteFile(file, []byte("hello,hallo\n\"hello, world\",\"hallo, Welt\"\n"), 0o644))
+	args := []string{"glossary", "sync", "--name", "terms", "--source", "EN", "--target", "DE", file}
+
+	status, stdout, stderr := runTest(t, server, "", args...)
+	assert.Equal(t, 0, status, stderr)
+	assert.Contains(t, stdout, "created: 2 added, 0 removed, 0 changed")
+
+	status, stdout, _ = runTest(t, server, "", args...)
+	assert.Equal(t, 0, status)
+	assert.Contains(t, stdout, "unchanged")
+}
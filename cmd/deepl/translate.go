@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+)
+
+const translateUsage = `Usage: deepl translate [--json] --target LANG [options] [TEXT...]
+
+Translate each TEXT, or text read from stdin when no TEXT is given.
+
+`
+
+func (c *cli) translate(args []string) error {
+	fs := c.newFlagSet("translate", translateUsage)
+	targetLang := fs.String("target", "", "target language, required")
+	sourceLang := fs.String("source", "", "source language, detected if not set")
+	formality := fs.String("formality", "", "formality: default, more, less, prefer_more or prefer_less")
+	glossaryID := fs.String("glossary", "", "ID of glossary to use, source language is required")
+	tagHandling := fs.String("tag-handling", "", "tag handling: xml or html")
+	translationContext := fs.String("context", "", "context influencing translation without being translated")
+	if err := fs.Parse(args); err != nil {
+		return c.parseError(err)
+	}
+	if *targetLang == "" {
+		fmt.Fprintln(c.stderr, "deepl: --target is required")
+		fs.Usage()
+		return errUsage
+	}
+
+	texts := fs.Args()
+	if len(texts) == 0 {
+		data, err := io.ReadAll(c.stdin)
+		if err != nil {
+			return err
+		}
+		texts = []string{strings.TrimRight(string(data), "\r\n")}
+	}
+
+	opts := []deeplgo.TranslateOption{}
+	if *sourceLang != "" {
+		opts = append(opts, deeplgo.WithSourceLang(*sourceLang))
+	}
+	if *formality != "" {
+		opts = append(opts, deeplgo.WithFormality(deeplgo.Formality(*formality)))
+	}
+	if *glossaryID != "" {
+		opts = append(opts, deeplgo.WithGlossaryID(deeplgo.GlossaryID(*glossaryID)))
+	}
+	if *tagHandling != "" {
+		opts = append(opts, deeplgo.WithTagHandling(deeplgo.TagHandling(*tagHandling)))
+	}
+	if *translationContext != "" {
+		opts = append(opts, deeplgo.WithTranslationContext(*translationContext))
+	}
+
+	translations, err := c.client.TranslateText(texts, *targetLang, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.print(translations, func(w io.Writer) {
+		for _, translation := range translations.Translations {
+			fmt.Fprintln(w, translation.Text)
+		}
+	})
+}
@@ -2,51 +2,60 @@ package deeplgo
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 )
 
 var (
-	errBadRequest               = errors.New("bad request")                                                                                      // 400
-	errAuthorizationFailed      = errors.New("authorization failed. please supply a valid `DeepL-Auth-Key` via the `Authorization` header")      // 401
-	errForbidden                = errors.New("forbidden. the access to the requested resource is denied, because of insufficient access rights") // 403
-	errRequestResourceNotFound  = errors.New("the requested resource could not be found")                                                        // 404
-	errRequestSizeExceedsLimit  = errors.New("the request size exceeds the limit")                                                               // 413
-	errAcceptHeaderNotSupported = errors.New("the requested entries format specified in the `Accept` header is not supported")                   // 415
-	errTooManyRequests          = errors.New("too many requests. please wait and resend your request")                                           // 429 && 529
-	errQuotaExceeded            = errors.New("quota exceeded. the character limit has been reached")                                             // 456
-	errInternalError            = errors.New("internal error")                                                                                   // 500
-	errResourceUnavailable      = errors.New("resource currently unavailable. try again later")                                                  // 503
+	ErrBadRequest               = errors.New("bad request")                                                                                      // 400
+	ErrAuthorizationFailed      = errors.New("authorization failed. please supply a valid `DeepL-Auth-Key` via the `Authorization` header")      // 401
+	ErrForbidden                = errors.New("forbidden. the access to the requested resource is denied, because of insufficient access rights") // 403
+	ErrRequestResourceNotFound  = errors.New("the requested resource could not be found")                                                        // 404
+	ErrRequestSizeExceedsLimit  = errors.New("the request size exceeds the limit")                                                               // 413
+	ErrAcceptHeaderNotSupported = errors.New("the requested entries format specified in the `Accept` header is not supported")                   // 415
+	ErrTooManyRequests          = errors.New("too many requests. please wait and resend your request")                                           // 429 && 529
+	ErrQuotaExceeded            = errors.New("quota exceeded. the character limit has been reached")                                             // 456
+	ErrInternalError            = errors.New("internal error")                                                                                   // 500
+	ErrResourceUnavailable      = errors.New("resource currently unavailable. try again later")                                                  // 503
 )
 
 var errCodes = map[int]error{
-	http.StatusBadRequest:            errBadRequest,
-	http.StatusUnauthorized:          errAuthorizationFailed,
-	http.StatusForbidden:             errForbidden,
-	http.StatusNotFound:              errRequestResourceNotFound,
-	http.StatusRequestEntityTooLarge: errRequestSizeExceedsLimit,
-	http.StatusUnsupportedMediaType:  errAcceptHeaderNotSupported,
-	http.StatusTooManyRequests:       errTooManyRequests,
-	456:                              errQuotaExceeded,
-	http.StatusInternalServerError:   errInternalError,
-	http.StatusServiceUnavailable:    errResourceUnavailable,
-	529:                              errTooManyRequests,
+	http.StatusBadRequest:            ErrBadRequest,
+	http.StatusUnauthorized:          ErrAuthorizationFailed,
+	http.StatusForbidden:             ErrForbidden,
+	http.StatusNotFound:              ErrRequestResourceNotFound,
+	http.StatusRequestEntityTooLarge: ErrRequestSizeExceedsLimit,
+	http.StatusUnsupportedMediaType:  ErrAcceptHeaderNotSupported,
+	http.StatusTooManyRequests:       ErrTooManyRequests,
+	456:                              ErrQuotaExceeded,
+	http.StatusInternalServerError:   ErrInternalError,
+	http.StatusServiceUnavailable:    ErrResourceUnavailable,
+	529:                              ErrTooManyRequests,
 }
 
+// Server URLs are not pinned to an API version, each endpoint carry its own
+// version as v2 translation and v3 glossaries are used together.
 var (
-	baseProUrl                    = "https://api.deepl.com/v2"
-	baseFreeUrl                   = "https://api-free.deepl.com/v2"
-	usageEndpoint                 = "/usage"
-	languagesEndpoint             = "/languages?target=%s"
-	glossaryLanguagePairsEndpoint = "/glossary-language-pairs"
-	translateEndpoint             = "/translate"
-	glossariesEndpoint            = "/glossaries"
-	glossaryEndpoint              = "/glossaries/%d"
-	glossaryEntriesEndpoint       = "/glossaries/%d/entries"
-	documentEndpoint              = "/document"
-	documentStatusEndpoint        = "/document/%d"
-	documentResultEndpoint        = "/document/%d/result"
+	baseProUrl                          = "https://api.deepl.com"
+	baseFreeUrl                         = "https://api-free.deepl.com"
+	usageEndpoint                       = "/v2/usage"
+	languagesEndpoint                   = "/v2/languages?type=%s"
+	glossaryLanguagePairsEndpoint       = "/v2/glossary-language-pairs"
+	translateEndpoint                   = "/v2/translate"
+	rephraseEndpoint                    = "/v2/write/rephrase"
+	glossariesEndpoint                  = "/v2/glossaries"
+	glossaryEndpoint                    = "/v2/glossaries/%s"
+	glossaryEntriesEndpoint             = "/v2/glossaries/%s/entries"
+	documentEndpoint                    = "/v2/document"
+	documentStatusEndpoint              = "/v2/document/%s"
+	documentResultEndpoint              = "/v2/document/%s/result"
+	multilingualGlossariesEndpoint      = "/v3/glossaries"
+	multilingualGlossaryEndpoint        = "/v3/glossaries/%s"
+	multilingualGlossaryEntriesEndpoint = "/v3/glossaries/%s/entries"
+	glossaryDictionariesEndpoint        = "/v3/glossaries/%s/dictionaries"
 )
 
 type ErrorMessage struct {
@@ -55,11 +64,16 @@ type ErrorMessage struct {
 }
 
 type Client struct {
-	baseURL    string
-	httpClient *HTTPClient
+	baseURL          string
+	httpClient       *HTTPClient
+	batchConcurrency int
+	catalog          *Catalog
 }
 
-func NewClient(apiKey string) *Client {
+// NewClient create a client for apiKey, server URL is taken from environment
+// variable `DEEPL_SERVER_URL` if set, otherwise it depends on API key type.
+// Options are applied in order.
+func NewClient(apiKey string, opts ...ClientOption) *Client {
 	httpClient := NewHTTPClient(apiKey)
 
 	baseURL, hasServerUrl := os.LookupEnv("DEEPL_SERVER_URL")
@@ -69,10 +83,16 @@ func NewClient(apiKey string) *Client {
 			baseURL = baseFreeUrl
 		}
 	}
-	return &Client{
-		baseURL:    baseURL,
-		httpClient: httpClient,
+	c := &Client{
+		baseURL:          trimAPIVersion(baseURL),
+		httpClient:       httpClient,
+		batchConcurrency: defaultBatchConcurrency,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 func (c *Client) GetApiKey() string {
@@ -87,6 +107,29 @@ func (c *Client) GetBaseUrl() string {
 	return c.baseURL
 }
 
+// SetBaseUrl change server URL, a trailing `/v2` of URLs used by previous
+// versions is removed.
 func (c *Client) SetBaseUrl(serverUrl string) {
-	c.baseURL = serverUrl
+	c.baseURL = trimAPIVersion(serverUrl)
+}
+
+// trimAPIVersion remove API version from end of serverURL, endpoints already
+// start with it.
+func trimAPIVersion(serverURL string) string {
+	if trimmed := strings.TrimSuffix(serverURL, "/"); strings.HasSuffix(trimmed, "/v2") {
+		return strings.TrimSuffix(trimmed, "/v2")
+	}
+
+	return serverURL
+}
+
+// endpointURL build URL of endpoint where each `%s` placeholder is replaced by
+// the matching id escaped as a path segment.
+func (c *Client) endpointURL(endpoint string, ids ...string) string {
+	escaped := make([]interface{}, len(ids))
+	for i, id := range ids {
+		escaped[i] = url.PathEscape(id)
+	}
+
+	return strings.TrimSuffix(c.baseURL, "/") + fmt.Sprintf(endpoint, escaped...)
 }
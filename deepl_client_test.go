@@ -1,57 +1,38 @@
 package deeplgo_test
 
 import (
-	"errors"
-	"net/http"
 	"os"
 	"testing"
 
 	deeplgo "github.com/ThibaudDemay/deepl-go"
+	"github.com/ThibaudDemay/deepl-go/deepltest"
 	"github.com/stretchr/testify/suite"
 )
 
-// Private from deeplgo, expose in blackbox testing for now
-var (
-	errBadRequest               = errors.New("bad request")                                                                                      // 400
-	errAuthorizationFailed      = errors.New("authorization failed. please supply a valid `DeepL-Auth-Key` via the `Authorization` header")      // 401
-	errForbidden                = errors.New("forbidden. the access to the requested resource is denied, because of insufficient access rights") // 403
-	errRequestResourceNotFound  = errors.New("the requested resource could not be found")                                                        // 404
-	errRequestSizeExceedsLimit  = errors.New("the request size exceeds the limit")                                                               // 413
-	errAcceptHeaderNotSupported = errors.New("the requested entries format specified in the `Accept` header is not supported")                   // 415
-	errTooManyRequests          = errors.New("too many requests. please wait and resend your request")                                           // 429 && 529
-	errQuotaExceeded            = errors.New("quota exceeded. the character limit has been reached")                                             // 456
-	errInternalError            = errors.New("internal error")                                                                                   // 500
-	errResourceUnavailable      = errors.New("resource currently unavailable. try again later")                                                  // 503
-)
-
-var errCodes = map[int]error{
-	http.StatusBadRequest:            errBadRequest,
-	http.StatusUnauthorized:          errAuthorizationFailed,
-	http.StatusForbidden:             errForbidden,
-	http.StatusNotFound:              errRequestResourceNotFound,
-	http.StatusRequestEntityTooLarge: errRequestSizeExceedsLimit,
-	http.StatusUnsupportedMediaType:  errAcceptHeaderNotSupported,
-	http.StatusTooManyRequests:       errTooManyRequests,
-	456:                              errQuotaExceeded,
-	http.StatusInternalServerError:   errInternalError,
-	http.StatusServiceUnavailable:    errResourceUnavailable,
-	529:                              errTooManyRequests,
-}
-
 type TestSuite struct {
 	suite.Suite
 	deeplClient *deeplgo.Client
+	server      *deepltest.Server
 }
 
+// SetupTest use DeepL API when `DEEPL_TEST_API_KEY` is set and not empty,
+// otherwise an in-process fake server.
 func (suite *TestSuite) SetupTest() {
-	suite.deeplClient = deeplgo.NewClient(getEnv("DEEPL_TEST_API_KEY", "DEEPL_NO_ENV_API_KEY"))
+	if apiKey := os.Getenv("DEEPL_TEST_API_KEY"); apiKey != "" {
+		suite.deeplClient = deeplgo.NewClient(apiKey)
+		return
+	}
+
+	suite.server = deepltest.NewServer()
+	suite.deeplClient = deeplgo.NewClient(suite.server.AuthKey(),
+		deeplgo.WithServerURL(suite.server.URL()))
 }
 
-func getEnv(key, fallback string) string {
-	if value, ok := os.LookupEnv(key); ok {
-		return value
+func (suite *TestSuite) TearDownTest() {
+	if suite.server != nil {
+		suite.server.Close()
+		suite.server = nil
 	}
-	return fallback
 }
 
 func (suite *TestSuite) Test_Client_GetSetter() {
@@ -69,7 +50,7 @@ func (suite *TestSuite) Test_Client_ApiKeyError() {
 	res, err := suite.deeplClient.GetUsage()
 
 	suite.Nil(res)
-	suite.ErrorContains(err, errForbidden.Error())
+	suite.ErrorIs(err, deeplgo.ErrForbidden)
 }
 
 // Test endpoint /usage
@@ -130,6 +111,69 @@ func (suite *TestSuite) Test_GlossaryLanguagePairs() {
 	suite.IsType(deeplgo.GlossaryLanguagePairs{}, *res)
 }
 
+// Test endpoint /translate with two texts
+// API return one translation by text without error
+func (suite *TestSuite) Test_TranslateText() {
+	res, err := suite.deeplClient.TranslateText([]string{"Hello", "World"}, "DE")
+
+	suite.Nil(err)
+	suite.NotEmpty(res)
+	suite.IsType(deeplgo.Translations{}, *res)
+	suite.Len(res.Translations, 2)
+}
+
+// Test endpoint /translate without text
+// Client return validation error without calling API
+func (suite *TestSuite) Test_TranslateText_NoText() {
+	res, err := suite.deeplClient.TranslateText([]string{}, "DE")
+
+	suite.Nil(res)
+	suite.Error(err)
+}
+
+// Test endpoint /translate with formality unknown by DeepL
+// Client return validation error without calling API
+func (suite *TestSuite) Test_TranslateText_InvalidFormality() {
+	res, err := suite.deeplClient.TranslateText([]string{"Hello"}, "DE",
+		deeplgo.WithFormality("very_formal"))
+
+	suite.Nil(res)
+	suite.ErrorContains(err, "'Formality' failed on the 'oneof' tag")
+}
+
+// Test endpoint /translate with glossary but no source language
+// Client return validation error without calling API
+func (suite *TestSuite) Test_TranslateText_GlossaryWithoutSourceLang() {
+	res, err := suite.deeplClient.TranslateText([]string{"Hello"}, "DE",
+		deeplgo.WithGlossaryID("def3a26b-3e84-45b3-84ae-0c0aaf3525f7"))
+
+	suite.Nil(res)
+	suite.ErrorContains(err, "'SourceLang' failed on the 'required_with' tag")
+}
+
+// Test endpoints /glossaries on a glossary lifecycle
+// API create, list, get, return entries and delete glossary without error
+func (suite *TestSuite) Test_Glossary_Lifecycle() {
+	entries := deeplgo.GlossaryEntries{"Apple": "Apfel", "Pear": "Birne"}
+	glossary, err := suite.deeplClient.CreateGlossary("deepl-go test", "en", "de", entries)
+	suite.Require().Nil(err)
+	suite.Equal(2, glossary.EntryCount)
+
+	glossaries, err := suite.deeplClient.ListGlossaries()
+	suite.Nil(err)
+	suite.IsType(deeplgo.Glossaries{}, *glossaries)
+
+	res, err := suite.deeplClient.GetGlossary(glossary.GlossaryID)
+	suite.Nil(err)
+	suite.Equal(glossary.GlossaryID, res.GlossaryID)
+
+	resEntries, err := suite.deeplClient.GetGlossaryEntries(glossary.GlossaryID)
+	suite.Nil(err)
+	suite.Equal(entries, resEntries)
+
+	suite.Nil(suite.deeplClient.DeleteGlossary(glossary.GlossaryID))
+}
+
 func TestRunSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -0,0 +1,180 @@
+// Package deeplmock provides a mock of deeplgo interfaces for unit tests of
+// code depending on DeepL. Each method call the matching function field, or
+// return ErrNotImplemented when it is nil, and is recorded in Calls.
+//
+//	mock := &deeplmock.Client{
+//		TranslateTextFunc: func(ctx context.Context, texts []string, targetLang string, opts ...deeplgo.TranslateOption) (*deeplgo.Translations, error) {
+//			return &deeplgo.Translations{Translations: []deeplgo.Translation{{Text: "Hallo"}}}, nil
+//		},
+//	}
+package deeplmock
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+)
+
+// ErrNotImplemented is returned by a method of Client without function set
+var ErrNotImplemented = errors.New("deeplmock: method not implemented")
+
+// Client implements Translator, GlossaryManager, DocumentTranslator and
+// UsageReporter of deeplgo.
+type Client struct {
+	TranslateTextFunc func(ctx context.Context, texts []string, targetLang string, opts ...deeplgo.TranslateOption) (*deeplgo.Translations, error)
+
+	CreateGlossaryFunc     func(ctx context.Context, name string, sourceLang string, targetLang string, entries deeplgo.GlossaryEntries) (*deeplgo.Glossary, error)
+	ListGlossariesFunc     func(ctx context.Context) (*deeplgo.Glossaries, error)
+	GetGlossaryFunc        func(ctx context.Context, glossaryID deeplgo.GlossaryID) (*deeplgo.Glossary, error)
+	DeleteGlossaryFunc     func(ctx context.Context, glossaryID deeplgo.GlossaryID) error
+	GetGlossaryEntriesFunc func(ctx context.Context, glossaryID deeplgo.GlossaryID) (deeplgo.GlossaryEntries, error)
+
+	UploadDocumentFunc    func(ctx context.Context, document io.Reader, filename string, targetLang string, opts ...deeplgo.DocumentOption) (*deeplgo.DocumentHandle, error)
+	GetDocumentStatusFunc func(ctx context.Context, handle deeplgo.DocumentHandle) (*deeplgo.DocumentStatus, error)
+	DownloadDocumentFunc  func(ctx context.Context, handle deeplgo.DocumentHandle, w io.Writer) error
+	TranslateDocumentFunc func(ctx context.Context, document io.Reader, filename string, w io.Writer, targetLang string, opts ...deeplgo.DocumentOption) (*deeplgo.DocumentStatus, error)
+
+	GetUsageFunc func(ctx context.Context) (*deeplgo.Usage, error)
+
+	mu    sync.Mutex
+	calls []string
+}
+
+var (
+	_ deeplgo.Translator         = (*Client)(nil)
+	_ deeplgo.GlossaryManager    = (*Client)(nil)
+	_ deeplgo.DocumentTranslator = (*Client)(nil)
+	_ deeplgo.UsageReporter      = (*Client)(nil)
+)
+
+// Calls return names of methods called, in order
+func (c *Client) Calls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string{}, c.calls...)
+}
+
+// CallCount return number of calls of method name
+func (c *Client) CallCount(name string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	for _, call := range c.calls {
+		if call == name {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (c *Client) record(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, name)
+}
+
+func (c *Client) TranslateTextContext(ctx context.Context, texts []string, targetLang string, opts ...deeplgo.TranslateOption) (*deeplgo.Translations, error) {
+	c.record("TranslateTextContext")
+	if c.TranslateTextFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.TranslateTextFunc(ctx, texts, targetLang, opts...)
+}
+
+func (c *Client) CreateGlossaryContext(ctx context.Context, name string, sourceLang string, targetLang string, entries deeplgo.GlossaryEntries) (*deeplgo.Glossary, error) {
+	c.record("CreateGlossaryContext")
+	if c.CreateGlossaryFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.CreateGlossaryFunc(ctx, name, sourceLang, targetLang, entries)
+}
+
+func (c *Client) ListGlossariesContext(ctx context.Context) (*deeplgo.Glossaries, error) {
+	c.record("ListGlossariesContext")
+	if c.ListGlossariesFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.ListGlossariesFunc(ctx)
+}
+
+func (c *Client) GetGlossaryContext(ctx context.Context, glossaryID deeplgo.GlossaryID) (*deeplgo.Glossary, error) {
+	c.record("GetGlossaryContext")
+	if c.GetGlossaryFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.GetGlossaryFunc(ctx, glossaryID)
+}
+
+func (c *Client) DeleteGlossaryContext(ctx context.Context, glossaryID deeplgo.GlossaryID) error {
+	c.record("DeleteGlossaryContext")
+	if c.DeleteGlossaryFunc == nil {
+		return ErrNotImplemented
+	}
+
+	return c.DeleteGlossaryFunc(ctx, glossaryID)
+}
+
+func (c *Client) GetGlossaryEntriesContext(ctx context.Context, glossaryID deeplgo.GlossaryID) (deeplgo.GlossaryEntries, error) {
+	c.record("GetGlossaryEntriesContext")
+	if c.GetGlossaryEntriesFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.GetGlossaryEntriesFunc(ctx, glossaryID)
+}
+
+func (c *Client) UploadDocumentContext(ctx context.Context, document io.Reader, filename string, targetLang string, opts ...deeplgo.DocumentOption) (*deeplgo.DocumentHandle, error) {
+	c.record("UploadDocumentContext")
+	if c.UploadDocumentFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.UploadDocumentFunc(ctx, document, filename, targetLang, opts...)
+}
+
+func (c *Client) GetDocumentStatusContext(ctx context.Context, handle deeplgo.DocumentHandle) (*deeplgo.DocumentStatus, error) {
+	c.record("GetDocumentStatusContext")
+	if c.GetDocumentStatusFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.GetDocumentStatusFunc(ctx, handle)
+}
+
+func (c *Client) DownloadDocumentContext(ctx context.Context, handle deeplgo.DocumentHandle, w io.Writer) error {
+	c.record("DownloadDocumentContext")
+	if c.DownloadDocumentFunc == nil {
+		return ErrNotImplemented
+	}
+
+	return c.DownloadDocumentFunc(ctx, handle, w)
+}
+
+func (c *Client) TranslateDocumentContext(ctx context.Context, document io.Reader, filename string, w io.Writer, targetLang string, opts ...deeplgo.DocumentOption) (*deeplgo.DocumentStatus, error) {
+	c.record("TranslateDocumentContext")
+	if c.TranslateDocumentFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.TranslateDocumentFunc(ctx, document, filename, w, targetLang, opts...)
+}
+
+func (c *Client) GetUsageContext(ctx context.Context) (*deeplgo.Usage, error) {
+	c.record("GetUsageContext")
+	if c.GetUsageFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return c.GetUsageFunc(ctx)
+}
@@ -0,0 +1,48 @@
+package deeplmock_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+	"github.com/ThibaudDemay/deepl-go/deeplmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client as Translator decorated by CachedTranslator
+// Mock must be called only for texts not in cache
+func Test_Client_CachedTranslator(t *testing.T) {
+	mock := &deeplmock.Client{
+		TranslateTextFunc: func(ctx context.Context, texts []string, targetLang string, opts ...deeplgo.TranslateOption) (*deeplgo.Translations, error) {
+			res := &deeplgo.Translations{}
+			for _, text := range texts {
+				res.Translations = append(res.Translations, deeplgo.Translation{
+					DetectedSourceLanguage: "EN",
+					Text:                   strings.ToUpper(text),
+				})
+			}
+			return res, nil
+		},
+	}
+	translator := deeplgo.NewCachedTranslator(mock, deeplgo.NewMemoryCache(10))
+
+	for i := 0; i < 3; i++ {
+		res, err := translator.TranslateText([]string{"hello"}, "DE")
+		assert.Nil(t, err)
+		assert.Equal(t, "HELLO", res.Translations[0].Text)
+	}
+
+	assert.Equal(t, 1, mock.CallCount("TranslateTextContext"))
+}
+
+// Test Client without function set
+// Method must return ErrNotImplemented and be recorded
+func Test_Client_NotImplemented(t *testing.T) {
+	mock := &deeplmock.Client{}
+
+	_, err := mock.GetUsageContext(context.Background())
+
+	assert.ErrorIs(t, err, deeplmock.ErrNotImplemented)
+	assert.Equal(t, []string{"GetUsageContext"}, mock.Calls())
+}
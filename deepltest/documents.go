@@ -0,0 +1,106 @@
+package deepltest
+
+import (
+	"io"
+	"net/http"
+	"strings"
+)
+
+type document struct {
+	key        string
+	targetLang string
+	content    string
+	polls      int
+}
+
+type documentKeyRequest struct {
+	DocumentKey string `json:"document_key"`
+}
+
+func (s *Server) handleDocumentUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	targetLang := r.FormValue("target_lang")
+	file, _, err := r.FormFile("file")
+	if err != nil || targetLang == "" {
+		writeError(w, http.StatusBadRequest, "Parameters 'file' and 'target_lang' are required")
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !s.consumeCharacters(string(content)) {
+		writeError(w, 456, "Quota exceeded")
+		return
+	}
+
+	id := s.newID(32)
+	key := s.newID(64)
+	s.mu.Lock()
+	s.documents[id] = &document{key: key, targetLang: targetLang, content: string(content)}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]string{"document_id": id, "document_key": key})
+}
+
+// handleDocument serve /document/{id} status and /document/{id}/result, a
+// document is done after the number of status requests set by
+// WithDocumentPolls and can be downloaded only once.
+func (s *Server) handleDocument(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v2/document/")
+	id, sub, _ := strings.Cut(path, "/")
+
+	req := documentKeyRequest{}
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, ok := s.documents[id]
+	if !ok || doc.key != req.DocumentKey {
+		writeError(w, http.StatusNotFound, "Document not found")
+		return
+	}
+
+	switch sub {
+	case "":
+		status := map[string]interface{}{"document_id": id}
+		switch {
+		case doc.polls < s.documentPolls && doc.polls == 0:
+			status["status"] = "queued"
+		case doc.polls < s.documentPolls:
+			status["status"] = "translating"
+			status["seconds_remaining"] = 1
+		default:
+			status["status"] = "done"
+			status["billed_characters"] = len([]rune(doc.content))
+		}
+		doc.polls++
+		writeJSON(w, http.StatusOK, status)
+	case "result":
+		if doc.polls <= s.documentPolls {
+			writeError(w, http.StatusServiceUnavailable, "Document translation is not done")
+			return
+		}
+		delete(s.documents, id)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		io.WriteString(w, FakeTranslation(doc.content, doc.targetLang))
+	default:
+		writeError(w, http.StatusNotFound, "Not found")
+	}
+}
@@ -0,0 +1,186 @@
+package deepltest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type glossary struct {
+	GlossaryID   string    `json:"glossary_id"`
+	Name         string    `json:"name"`
+	Ready        bool      `json:"ready"`
+	SourceLang   string    `json:"source_lang"`
+	TargetLang   string    `json:"target_lang"`
+	CreationTime time.Time `json:"creation_time"`
+	EntryCount   int       `json:"entry_count"`
+
+	entries [][2]string
+}
+
+type createGlossaryRequest struct {
+	Name          string `json:"name"`
+	SourceLang    string `json:"source_lang"`
+	TargetLang    string `json:"target_lang"`
+	Entries       string `json:"entries"`
+	EntriesFormat string `json:"entries_format"`
+}
+
+// handleGlossaries create a glossary on POST and list glossaries on GET
+func (s *Server) handleGlossaries(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.mu.Lock()
+		glossaries := []*glossary{}
+		for _, id := range s.glossaryOrder {
+			glossaries = append(glossaries, s.glossaries[id])
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"glossaries": glossaries})
+		s.mu.Unlock()
+	case http.MethodPost:
+		s.createGlossary(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) createGlossary(w http.ResponseWriter, r *http.Request) {
+	req := createGlossaryRequest{}
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Name == "" || req.SourceLang == "" || req.TargetLang == "" || req.Entries == "" {
+		writeError(w, http.StatusBadRequest, "Parameters 'name', 'source_lang', 'target_lang' and 'entries' are required")
+		return
+	}
+	if !supportedGlossaryPair(req.SourceLang, req.TargetLang) {
+		writeError(w, http.StatusBadRequest, "Unsupported glossary source and target language pair")
+		return
+	}
+
+	entries, err := parseEntries(req.Entries, req.EntriesFormat)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	g := &glossary{
+		GlossaryID:   s.newGlossaryID(),
+		Name:         req.Name,
+		Ready:        true,
+		SourceLang:   strings.ToLower(req.SourceLang),
+		TargetLang:   strings.ToLower(req.TargetLang),
+		CreationTime: time.Now().UTC(),
+		EntryCount:   len(entries),
+		entries:      entries,
+	}
+	s.mu.Lock()
+	s.glossaries[g.GlossaryID] = g
+	s.glossaryOrder = append(s.glossaryOrder, g.GlossaryID)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, g)
+}
+
+// handleGlossary serve /glossaries/{id} and /glossaries/{id}/entries
+func (s *Server) handleGlossary(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/v2/glossaries/")
+	id, sub, _ := strings.Cut(path, "/")
+
+	s.mu.Lock()
+	g, ok := s.glossaries[id]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "Glossary not found")
+		return
+	}
+
+	switch {
+	case sub == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, g)
+	case sub == "" && r.Method == http.MethodDelete:
+		s.deleteGlossary(id)
+		w.WriteHeader(http.StatusNoContent)
+	case sub == "entries" && r.Method == http.MethodGet:
+		accept := r.Header.Get("Accept")
+		if accept != "" && accept != "*/*" && accept != "text/tab-separated-values" {
+			writeError(w, http.StatusUnsupportedMediaType, "Unsupported entries format")
+			return
+		}
+		w.Header().Set("Content-Type", "text/tab-separated-values")
+		for _, entry := range g.entries {
+			fmt.Fprintf(w, "%s\t%s\n", entry[0], entry[1])
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) deleteGlossary(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.glossaries, id)
+	for i, orderID := range s.glossaryOrder {
+		if orderID == id {
+			s.glossaryOrder = append(s.glossaryOrder[:i], s.glossaryOrder[i+1:]...)
+			break
+		}
+	}
+}
+
+// newGlossaryID return a deterministic identifier formatted as an UUID
+func (s *Server) newGlossaryID() string {
+	id := strings.ToLower(s.newID(32))
+
+	return id[:8] + "-" + id[8:12] + "-" + id[12:16] + "-" + id[16:20] + "-" + id[20:]
+}
+
+func supportedGlossaryPair(sourceLang string, targetLang string) bool {
+	for _, pair := range glossaryLanguagePairs {
+		if strings.EqualFold(pair[0], sourceLang) && strings.EqualFold(pair[1], targetLang) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseEntries read entries in TSV or CSV format
+func parseEntries(data string, format string) ([][2]string, error) {
+	records := [][]string{}
+	switch format {
+	case "", "tsv":
+		for _, line := range strings.Split(data, "\n") {
+			line = strings.TrimSuffix(line, "\r")
+			if line != "" {
+				records = append(records, strings.Split(line, "\t"))
+			}
+		}
+	case "csv":
+		var err error
+		if records, err = csv.NewReader(strings.NewReader(data)).ReadAll(); err != nil {
+			return nil, fmt.Errorf("invalid glossary entries: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported entries format: %s", format)
+	}
+
+	entries := make([][2]string, 0, len(records))
+	sources := map[string]bool{}
+	for i, record := range records {
+		if len(record) != 2 || record[0] == "" || record[1] == "" {
+			return nil, fmt.Errorf("invalid glossary entry on line %d", i+1)
+		}
+		if sources[record[0]] {
+			return nil, fmt.Errorf("duplicate source term: %s", record[0])
+		}
+		sources[record[0]] = true
+		entries = append(entries, [2]string{record[0], record[1]})
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,245 @@
+package deepltest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type dictionary struct {
+	SourceLang string `json:"source_lang"`
+	TargetLang string `json:"target_lang"`
+	EntryCount int    `json:"entry_count"`
+
+	entries [][2]string
+}
+
+type multilingualGlossary struct {
+	GlossaryID   string        `json:"glossary_id"`
+	Name         string        `json:"name"`
+	Dictionaries []*dictionary `json:"dictionaries"`
+	CreationTime time.Time     `json:"creation_time"`
+}
+
+type dictionaryRequest struct {
+	SourceLang    string `json:"source_lang"`
+	TargetLang    string `json:"target_lang"`
+	Entries       string `json:"entries"`
+	EntriesFormat string `json:"entries_format"`
+}
+
+type multilingualGlossaryRequest struct {
+	Name         string              `json:"name"`
+	Dictionaries []dictionaryRequest `json:"dictionaries"`
+}
+
+// handleMultilingualGlossaries create a glossary on POST and list glossaries
+// on GET
+func (s *Server) handleMultilingualGlossaries(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.mu.Lock()
+		glossaries := []*multilingualGlossary{}
+		for _, id := range s.multilingualGlossaryOrder {
+			glossaries = append(glossaries, s.multilingualGlossaries[id])
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"glossaries": glossaries})
+		s.mu.Unlock()
+	case http.MethodPost:
+		s.createMultilingualGlossary(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) createMultilingualGlossary(w http.ResponseWriter, r *http.Request) {
+	req := multilingualGlossaryRequest{}
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Name == "" || len(req.Dictionaries) == 0 {
+		writeError(w, http.StatusBadRequest, "Parameters 'name' and 'dictionaries' are required")
+		return
+	}
+
+	g := &multilingualGlossary{
+		GlossaryID:   s.newGlossaryID(),
+		Name:         req.Name,
+		CreationTime: time.Now().UTC(),
+	}
+	for _, dictionaryReq := range req.Dictionaries {
+		d, err := newDictionary(dictionaryReq)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if g.dictionary(d.SourceLang, d.TargetLang) != nil {
+			writeError(w, http.StatusBadRequest, "Duplicate dictionary language pair")
+			return
+		}
+		g.Dictionaries = append(g.Dictionaries, d)
+	}
+
+	s.mu.Lock()
+	s.multilingualGlossaries[g.GlossaryID] = g
+	s.multilingualGlossaryOrder = append(s.multilingualGlossaryOrder, g.GlossaryID)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, g)
+}
+
+// handleMultilingualGlossary serve /v3/glossaries/{id},
+// /v3/glossaries/{id}/dictionaries and /v3/glossaries/{id}/entries
+func (s *Server) handleMultilingualGlossary(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/v3/glossaries/")
+	id, sub, _ := strings.Cut(path, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.multilingualGlossaries[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Glossary not found")
+		return
+	}
+
+	switch {
+	case sub == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, g)
+	case sub == "" && r.Method == http.MethodDelete:
+		delete(s.multilingualGlossaries, id)
+		for i, orderID := range s.multilingualGlossaryOrder {
+			if orderID == id {
+				s.multilingualGlossaryOrder = append(s.multilingualGlossaryOrder[:i], s.multilingualGlossaryOrder[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case sub == "" && r.Method == http.MethodPatch:
+		req := multilingualGlossaryRequest{}
+		if err := decodeRequest(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, dictionaryReq := range req.Dictionaries {
+			d, err := newDictionary(dictionaryReq)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			g.mergeDictionary(d)
+		}
+		if req.Name != "" {
+			g.Name = req.Name
+		}
+		writeJSON(w, http.StatusOK, g)
+	case sub == "dictionaries" && r.Method == http.MethodPut:
+		req := dictionaryRequest{}
+		if err := decodeRequest(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		d, err := newDictionary(req)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		g.removeDictionary(d.SourceLang, d.TargetLang)
+		g.Dictionaries = append(g.Dictionaries, d)
+		writeJSON(w, http.StatusOK, d)
+	case sub == "dictionaries" && r.Method == http.MethodDelete:
+		query := r.URL.Query()
+		if !g.removeDictionary(query.Get("source_lang"), query.Get("target_lang")) {
+			writeError(w, http.StatusNotFound, "Dictionary not found")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case sub == "entries" && r.Method == http.MethodGet:
+		query := r.URL.Query()
+		d := g.dictionary(query.Get("source_lang"), query.Get("target_lang"))
+		if d == nil {
+			writeError(w, http.StatusNotFound, "Dictionary not found")
+			return
+		}
+		var entries strings.Builder
+		for _, entry := range d.entries {
+			fmt.Fprintf(&entries, "%s\t%s\n", entry[0], entry[1])
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"dictionaries": []map[string]string{{
+			"source_lang":    d.SourceLang,
+			"target_lang":    d.TargetLang,
+			"entries":        entries.String(),
+			"entries_format": "tsv",
+		}}})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func newDictionary(req dictionaryRequest) (*dictionary, error) {
+	if req.SourceLang == "" || req.TargetLang == "" {
+		return nil, fmt.Errorf("parameters 'source_lang' and 'target_lang' are required")
+	}
+	if !supportedGlossaryPair(req.SourceLang, req.TargetLang) {
+		return nil, fmt.Errorf("unsupported glossary source and target language pair")
+	}
+
+	entries, err := parseEntries(req.Entries, req.EntriesFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dictionary{
+		SourceLang: strings.ToLower(req.SourceLang),
+		TargetLang: strings.ToLower(req.TargetLang),
+		EntryCount: len(entries),
+		entries:    entries,
+	}, nil
+}
+
+func (g *multilingualGlossary) dictionary(sourceLang string, targetLang string) *dictionary {
+	for _, d := range g.Dictionaries {
+		if strings.EqualFold(d.SourceLang, sourceLang) && strings.EqualFold(d.TargetLang, targetLang) {
+			return d
+		}
+	}
+
+	return nil
+}
+
+func (g *multilingualGlossary) removeDictionary(sourceLang string, targetLang string) bool {
+	for i, d := range g.Dictionaries {
+		if strings.EqualFold(d.SourceLang, sourceLang) && strings.EqualFold(d.TargetLang, targetLang) {
+			g.Dictionaries = append(g.Dictionaries[:i], g.Dictionaries[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeDictionary add entries of d to dictionary of the same language pair,
+// replacing target of entries already present.
+func (g *multilingualGlossary) mergeDictionary(d *dictionary) {
+	existing := g.dictionary(d.SourceLang, d.TargetLang)
+	if existing == nil {
+		g.Dictionaries = append(g.Dictionaries, d)
+		return
+	}
+
+	for _, entry := range d.entries {
+		replaced := false
+		for i := range existing.entries {
+			if existing.entries[i][0] == entry[0] {
+				existing.entries[i][1] = entry[1]
+				replaced = true
+			}
+		}
+		if !replaced {
+			existing.entries = append(existing.entries, entry)
+		}
+	}
+	existing.EntryCount = len(existing.entries)
+}
@@ -0,0 +1,452 @@
+// Package deepltest provides an in-process fake of DeepL API for tests. It
+// serves usage, languages, glossary language pairs, translation, rephrasing,
+// glossaries of API v2 and v3 and documents endpoints with deterministic fake
+// translations, and can simulate quota, failures and latency.
+//
+//	server := deepltest.NewServer()
+//	defer server.Close()
+//	client := deeplgo.NewClient(server.AuthKey())
+//	client.SetBaseUrl(server.URL())
+package deepltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const DefaultAuthKey = "deepltest-auth-key"
+
+// Server is a fake DeepL API listening on a local address
+type Server struct {
+	httpServer *httptest.Server
+
+	mu             sync.Mutex
+	authKey        string
+	characterCount int
+	characterLimit int
+	latency        time.Duration
+	failures       []int
+	requests       int
+	glossaries     map[string]*glossary
+	glossaryOrder  []string
+	documents      map[string]*document
+	documentPolls  int
+	nextID         int
+
+	multilingualGlossaries    map[string]*multilingualGlossary
+	multilingualGlossaryOrder []string
+}
+
+// Option configure a Server created by NewServer
+type Option func(*Server)
+
+// WithAuthKey set the only API key accepted, others get 403 forbidden
+func WithAuthKey(authKey string) Option {
+	return func(s *Server) {
+		s.authKey = authKey
+	}
+}
+
+// WithCharacterLimit set quota of characters, translations beyond it get 456
+// quota exceeded. Zero means no limit.
+func WithCharacterLimit(characterLimit int) Option {
+	return func(s *Server) {
+		s.characterLimit = characterLimit
+	}
+}
+
+// WithLatency delay every response
+func WithLatency(latency time.Duration) Option {
+	return func(s *Server) {
+		s.latency = latency
+	}
+}
+
+// WithDocumentPolls set number of status requests answered before a document
+// translation is done.
+func WithDocumentPolls(documentPolls int) Option {
+	return func(s *Server) {
+		s.documentPolls = documentPolls
+	}
+}
+
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		authKey:        DefaultAuthKey,
+		characterLimit: 500000,
+		glossaries:     map[string]*glossary{},
+		documents:      map[string]*document{},
+
+		multilingualGlossaries: map[string]*multilingualGlossary{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/usage", s.handleUsage)
+	mux.HandleFunc("/v2/languages", s.handleLanguages)
+	mux.HandleFunc("/v2/glossary-language-pairs", s.handleGlossaryLanguagePairs)
+	mux.HandleFunc("/v2/translate", s.handleTranslate)
+	mux.HandleFunc("/v2/write/rephrase", s.handleRephrase)
+	mux.HandleFunc("/v2/glossaries", s.handleGlossaries)
+	mux.HandleFunc("/v2/glossaries/", s.handleGlossary)
+	mux.HandleFunc("/v2/document", s.handleDocumentUpload)
+	mux.HandleFunc("/v2/document/", s.handleDocument)
+	mux.HandleFunc("/v3/glossaries", s.handleMultilingualGlossaries)
+	mux.HandleFunc("/v3/glossaries/", s.handleMultilingualGlossary)
+	s.httpServer = httptest.NewServer(s.middleware(mux))
+
+	return s
+}
+
+// URL return server URL to give to Client.SetBaseUrl, without API version
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+func (s *Server) AuthKey() string {
+	return s.authKey
+}
+
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// FailNext answer next count requests with statusCode, like 429 too many
+// requests, 456 quota exceeded or 503 resource unavailable.
+func (s *Server) FailNext(statusCode int, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < count; i++ {
+		s.failures = append(s.failures, statusCode)
+	}
+}
+
+// Requests return number of requests received, including failed ones
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// CharacterCount return number of characters translated so far
+func (s *Server) CharacterCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.characterCount
+}
+
+// SetCharacterCount change number of characters already translated, to
+// simulate a quota almost reached.
+func (s *Server) SetCharacterCount(characterCount int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.characterCount = characterCount
+}
+
+// FakeTranslation return translation of text into targetLang as made by
+// Server, text prefixed by target language.
+func FakeTranslation(text string, targetLang string) string {
+	if text == "" {
+		return ""
+	}
+
+	return "[" + strings.ToUpper(targetLang) + "] " + text
+}
+
+// FakeRephrasing return text improved by Server, text prefixed by a marker
+func FakeRephrasing(text string) string {
+	if text == "" {
+		return ""
+	}
+
+	return "[REPHRASED] " + text
+}
+
+// middleware apply latency, injected failures and authentication before
+// handlers.
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		latency := s.latency
+		failure := 0
+		if len(s.failures) > 0 {
+			failure = s.failures[0]
+			s.failures = s.failures[1:]
+		}
+		s.mu.Unlock()
+
+		if latency > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(latency):
+			}
+		}
+
+		if failure != 0 {
+			writeError(w, failure, "injected failure")
+			return
+		}
+		if r.Header.Get("Authorization") != "DeepL-Auth-Key "+s.authKey {
+			writeError(w, http.StatusForbidden, "Wrong endpoint. Use https://api.deepl.com")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// consumeCharacters add characters of texts to usage, it return false when
+// quota would be exceeded.
+func (s *Server) consumeCharacters(texts ...string) bool {
+	count := 0
+	for _, text := range texts {
+		count += utf8.RuneCountInString(text)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.characterLimit > 0 && s.characterCount+count > s.characterLimit {
+		return false
+	}
+	s.characterCount += count
+
+	return true
+}
+
+func (s *Server) newID(size int) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	return fmt.Sprintf("%0*X", size, s.nextID)
+}
+
+func (s *Server) handleUsage(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]int{
+		"character_count": s.characterCount,
+		"character_limit": s.characterLimit,
+	})
+}
+
+type language struct {
+	Language          string `json:"language"`
+	Name              string `json:"name"`
+	SupportsFormality bool   `json:"supports_formality"`
+}
+
+var sourceLanguages = []language{
+	{Language: "DE", Name: "German"},
+	{Language: "EN", Name: "English"},
+	{Language: "ES", Name: "Spanish"},
+	{Language: "FR", Name: "French"},
+	{Language: "JA", Name: "Japanese"},
+	{Language: "PT", Name: "Portuguese"},
+	{Language: "ZH", Name: "Chinese"},
+}
+
+var targetLanguages = []language{
+	{Language: "DE", Name: "German", SupportsFormality: true},
+	{Language: "EN-GB", Name: "English (British)"},
+	{Language: "EN-US", Name: "English (American)"},
+	{Language: "ES", Name: "Spanish", SupportsFormality: true},
+	{Language: "FR", Name: "French", SupportsFormality: true},
+	{Language: "JA", Name: "Japanese", SupportsFormality: true},
+	{Language: "PT-BR", Name: "Portuguese (Brazilian)", SupportsFormality: true},
+	{Language: "PT-PT", Name: "Portuguese (European)", SupportsFormality: true},
+	{Language: "ZH-HANS", Name: "Chinese (simplified)"},
+}
+
+var glossaryLanguagePairs = [][2]string{
+	{"de", "en"}, {"en", "de"},
+	{"en", "es"}, {"es", "en"},
+	{"en", "fr"}, {"fr", "en"},
+	{"en", "ja"}, {"ja", "en"},
+}
+
+// handleLanguages return source languages unless type is target, like DeepL
+// does for an unknown type.
+func (s *Server) handleLanguages(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("type") == "target" {
+		writeJSON(w, http.StatusOK, targetLanguages)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, sourceLanguages)
+}
+
+func (s *Server) handleGlossaryLanguagePairs(w http.ResponseWriter, r *http.Request) {
+	pairs := []map[string]string{}
+	for _, pair := range glossaryLanguagePairs {
+		pairs = append(pairs, map[string]string{"source_lang": pair[0], "target_lang": pair[1]})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"supported_languages": pairs})
+}
+
+type translateRequest struct {
+	Text                 []string `json:"text"`
+	TargetLang           string   `json:"target_lang"`
+	SourceLang           string   `json:"source_lang"`
+	GlossaryID           string   `json:"glossary_id"`
+	ShowBilledCharacters bool     `json:"show_billed_characters"`
+}
+
+type translation struct {
+	DetectedSourceLanguage string `json:"detected_source_language"`
+	Text                   string `json:"text"`
+	BilledCharacters       int    `json:"billed_characters,omitempty"`
+}
+
+func (s *Server) handleTranslate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	req := translateRequest{}
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(req.Text) == 0 || req.TargetLang == "" {
+		writeError(w, http.StatusBadRequest, "Parameter 'text' and 'target_lang' are required")
+		return
+	}
+	if req.GlossaryID != "" {
+		s.mu.Lock()
+		_, ok := s.glossaries[req.GlossaryID]
+		_, okMultilingual := s.multilingualGlossaries[req.GlossaryID]
+		s.mu.Unlock()
+		if !ok && !okMultilingual {
+			writeError(w, http.StatusNotFound, "Glossary not found")
+			return
+		}
+	}
+	if !s.consumeCharacters(req.Text...) {
+		writeError(w, 456, "Quota exceeded")
+		return
+	}
+
+	sourceLang := strings.ToUpper(req.SourceLang)
+	if sourceLang == "" {
+		sourceLang = "EN"
+	}
+	translations := []translation{}
+	for _, text := range req.Text {
+		t := translation{DetectedSourceLanguage: sourceLang, Text: FakeTranslation(text, req.TargetLang)}
+		if req.ShowBilledCharacters {
+			t.BilledCharacters = utf8.RuneCountInString(text)
+		}
+		translations = append(translations, t)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"translations": translations})
+}
+
+type rephraseRequest struct {
+	Text         []string `json:"text"`
+	TargetLang   string   `json:"target_lang"`
+	WritingStyle string   `json:"writing_style"`
+	Tone         string   `json:"tone"`
+}
+
+type improvement struct {
+	Text                   string `json:"text"`
+	TargetLanguage         string `json:"target_language"`
+	DetectedSourceLanguage string `json:"detected_source_language"`
+}
+
+func (s *Server) handleRephrase(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	req := rephraseRequest{}
+	if err := decodeRequest(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(req.Text) == 0 {
+		writeError(w, http.StatusBadRequest, "Parameter 'text' is required")
+		return
+	}
+	if req.WritingStyle != "" && req.Tone != "" {
+		writeError(w, http.StatusBadRequest, "Both writing_style and tone defined")
+		return
+	}
+	if !s.consumeCharacters(req.Text...) {
+		writeError(w, 456, "Quota exceeded")
+		return
+	}
+
+	targetLang := strings.ToLower(req.TargetLang)
+	if targetLang == "" {
+		targetLang = "en-US"
+	}
+	improvements := []improvement{}
+	for _, text := range req.Text {
+		improvements = append(improvements, improvement{
+			Text:                   FakeRephrasing(text),
+			TargetLanguage:         targetLang,
+			DetectedSourceLanguage: "en",
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"improvements": improvements})
+}
+
+// decodeRequest read parameters from a JSON body or from a form
+func decodeRequest(r *http.Request, data interface{}) error {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		return json.NewDecoder(r.Body).Decode(data)
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	values := map[string]interface{}{}
+	for key, value := range r.PostForm {
+		if key == "text" {
+			values[key] = value
+		} else if value[0] == "true" || value[0] == "false" {
+			values[key] = value[0] == "true"
+		} else {
+			values[key] = value[0]
+		}
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(encoded, data)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(data)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]string{"message": message})
+}
@@ -0,0 +1,166 @@
+package deepltest_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	deeplgo "github.com/ThibaudDemay/deepl-go"
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClient(server *deepltest.Server) *deeplgo.Client {
+	return deeplgo.NewClient(server.AuthKey(),
+		deeplgo.WithServerURL(server.URL()),
+		deeplgo.WithRetryPolicy(deeplgo.NoRetryPolicy()),
+	)
+}
+
+// Test Server translation
+// Server must return fake translation and count characters
+func Test_Server_Translate(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := newClient(server)
+
+	res, err := c.TranslateText([]string{"Hello", "World"}, "DE", deeplgo.WithShowBilledCharacters(true))
+
+	assert.Nil(t, err)
+	assert.Equal(t, deepltest.FakeTranslation("Hello", "DE"), res.Translations[0].Text)
+	assert.Equal(t, 5, res.Translations[1].BilledCharacters)
+	assert.Equal(t, 10, server.CharacterCount())
+}
+
+// Test Server with quota almost reached
+// Server must return quota exceeded without counting characters
+func Test_Server_Quota(t *testing.T) {
+	server := deepltest.NewServer(deepltest.WithCharacterLimit(10))
+	defer server.Close()
+	c := newClient(server)
+	server.SetCharacterCount(8)
+
+	_, err := c.TranslateText([]string{"Hello"}, "DE")
+
+	assert.ErrorIs(t, err, deeplgo.ErrQuotaExceeded)
+	assert.Equal(t, 8, server.CharacterCount())
+}
+
+// Test Server with injected failures
+// Server must fail next requests then answer normally
+func Test_Server_FailNext(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := newClient(server)
+	server.FailNext(503, 1)
+	server.FailNext(429, 1)
+
+	_, err := c.GetUsage()
+	assert.ErrorIs(t, err, deeplgo.ErrResourceUnavailable)
+	_, err = c.GetUsage()
+	assert.ErrorIs(t, err, deeplgo.ErrTooManyRequests)
+	_, err = c.GetUsage()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, server.Requests())
+}
+
+// Test Server with latency longer than client deadline
+// Client must return error of context
+func Test_Server_Latency(t *testing.T) {
+	server := deepltest.NewServer(deepltest.WithLatency(time.Second))
+	defer server.Close()
+	c := newClient(server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := c.GetUsageContext(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// Test Server document translation
+// Server must translate document once it is done
+func Test_Server_Document(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := newClient(server)
+
+	out := &bytes.Buffer{}
+	status, err := c.TranslateDocument(strings.NewReader("Hello"), "hello.txt", out, "FR")
+
+	assert.Nil(t, err)
+	assert.Equal(t, deeplgo.DocumentStatusDone, status.Status)
+	assert.Equal(t, deepltest.FakeTranslation("Hello", "FR"), out.String())
+}
+
+// Test Server glossary with unsupported language pair
+// Server must return bad request
+func Test_Server_GlossaryUnsupportedPair(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := newClient(server)
+
+	_, err := c.CreateGlossary("test", "de", "ja", map[string]string{"Apfel": "りんご"})
+
+	assert.ErrorIs(t, err, deeplgo.ErrBadRequest)
+}
+
+// Test Server rephrasing
+// Server must return fake improvements and count characters
+func Test_Server_Rephrase(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := newClient(server)
+
+	res, err := c.RephraseText([]string{"Hello"}, "", deeplgo.WithTone(deeplgo.ToneFriendly))
+
+	assert.Nil(t, err)
+	assert.Equal(t, deepltest.FakeRephrasing("Hello"), res.Improvements[0].Text)
+	assert.Equal(t, "en", res.Improvements[0].DetectedSourceLanguage)
+	assert.Equal(t, 5, server.CharacterCount())
+}
+
+// Test Server multilingual glossaries
+// Dictionaries must be created, merged, replaced and deleted per language pair
+func Test_Server_MultilingualGlossary(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := newClient(server)
+
+	glossary, err := c.CreateMultilingualGlossary("test", []deeplgo.DictionaryEntries{
+		{SourceLang: "en", TargetLang: "de", Entries: map[string]string{"hello": "hallo"}},
+		{SourceLang: "en", TargetLang: "fr", Entries: map[string]string{"hello": "bonjour"}},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, glossary.Dictionaries, 2)
+
+	_, err = c.UpdateGlossaryDictionary(glossary.GlossaryID, deeplgo.DictionaryEntries{
+		SourceLang: "en", TargetLang: "de", Entries: map[string]string{"world": "welt"},
+	})
+	assert.Nil(t, err)
+	entries, err := c.GetGlossaryDictionaryEntries(glossary.GlossaryID, "en", "de")
+	assert.Nil(t, err)
+	assert.Equal(t, deeplgo.GlossaryEntries{"hello": "hallo", "world": "welt"}, entries)
+
+	_, err = c.ReplaceGlossaryDictionary(glossary.GlossaryID, deeplgo.DictionaryEntries{
+		SourceLang: "en", TargetLang: "fr", Entries: map[string]string{"world": "monde"},
+	})
+	assert.Nil(t, err)
+	entries, err = c.GetGlossaryDictionaryEntries(glossary.GlossaryID, "en", "fr")
+	assert.Nil(t, err)
+	assert.Equal(t, deeplgo.GlossaryEntries{"world": "monde"}, entries)
+
+	assert.Nil(t, c.DeleteGlossaryDictionary(glossary.GlossaryID, "en", "de"))
+	dictionaries, err := c.ListGlossaryDictionaries(glossary.GlossaryID)
+	assert.Nil(t, err)
+	assert.Equal(t, []deeplgo.GlossaryDictionary{{SourceLang: "en", TargetLang: "fr", EntryCount: 1}}, dictionaries)
+
+	_, err = c.TranslateText([]string{"world"}, "FR", deeplgo.WithSourceLang("EN"), deeplgo.WithGlossaryID(glossary.GlossaryID))
+	assert.Nil(t, err)
+
+	assert.Nil(t, c.DeleteMultilingualGlossary(glossary.GlossaryID))
+	_, err = c.GetMultilingualGlossary(glossary.GlossaryID)
+	assert.ErrorIs(t, err, deeplgo.ErrRequestResourceNotFound)
+}
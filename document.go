@@ -0,0 +1,221 @@
+package deeplgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// DocumentID identify a document uploaded for translation
+type DocumentID string
+
+// DocumentKey is the secret returned with DocumentID on upload, it is required
+// to query status and result of the document.
+type DocumentKey string
+
+type DocumentStatusCode string
+
+const (
+	DocumentStatusQueued      DocumentStatusCode = "queued"
+	DocumentStatusTranslating DocumentStatusCode = "translating"
+	DocumentStatusDone        DocumentStatusCode = "done"
+	DocumentStatusError       DocumentStatusCode = "error"
+)
+
+// Interval between two status requests of TranslateDocument, DeepL estimation
+// of remaining time is used when it is between both bounds.
+var (
+	documentMinPollInterval = time.Second
+	documentMaxPollInterval = 30 * time.Second
+)
+
+type DocumentHandle struct {
+	DocumentID  DocumentID  `json:"document_id" validate:"required"`
+	DocumentKey DocumentKey `json:"document_key" validate:"required"`
+}
+
+type DocumentStatus struct {
+	DocumentID       DocumentID         `json:"document_id" validate:"required"`
+	Status           DocumentStatusCode `json:"status" validate:"required,oneof=queued translating done error"`
+	SecondsRemaining int                `json:"seconds_remaining,omitempty"`
+	BilledCharacters int                `json:"billed_characters,omitempty"`
+	ErrorMessage     string             `json:"error_message,omitempty"`
+}
+
+// DocumentError is returned by TranslateDocument when DeepL fail to translate
+// document after upload.
+type DocumentError struct {
+	DocumentID DocumentID
+	Message    string
+}
+
+func (e *DocumentError) Error() string {
+	return fmt.Sprintf("translation of document %s failed: %s", e.DocumentID, e.Message)
+}
+
+type DocumentOptions struct {
+	SourceLang   string    `validate:"required_with=GlossaryID"`
+	Formality    Formality `validate:"omitempty,oneof=default more less prefer_more prefer_less"`
+	GlossaryID   GlossaryID
+	OutputFormat string
+}
+
+// DocumentOption set an optional parameter of a document upload
+type DocumentOption func(*DocumentOptions)
+
+func WithDocumentSourceLang(sourceLang string) DocumentOption {
+	return func(o *DocumentOptions) {
+		o.SourceLang = sourceLang
+	}
+}
+
+func WithDocumentFormality(formality Formality) DocumentOption {
+	return func(o *DocumentOptions) {
+		o.Formality = formality
+	}
+}
+
+func WithDocumentGlossaryID(glossaryID GlossaryID) DocumentOption {
+	return func(o *DocumentOptions) {
+		o.GlossaryID = glossaryID
+	}
+}
+
+// WithOutputFormat ask for a translated document in another format than the
+// uploaded one, format is a file extension like "docx".
+func WithOutputFormat(outputFormat string) DocumentOption {
+	return func(o *DocumentOptions) {
+		o.OutputFormat = outputFormat
+	}
+}
+
+type documentKeyRequest struct {
+	DocumentKey DocumentKey `json:"document_key"`
+}
+
+// UploadDocument send content of document named filename to be translated
+// into targetLang, returned handle is needed to follow translation.
+func (c *Client) UploadDocument(document io.Reader, filename string, targetLang string, opts ...DocumentOption) (*DocumentHandle, error) {
+	return c.UploadDocumentContext(context.Background(), document, filename, targetLang, opts...)
+}
+
+func (c *Client) UploadDocumentContext(ctx context.Context, document io.Reader, filename string, targetLang string, opts ...DocumentOption) (*DocumentHandle, error) {
+	url := c.endpointURL(documentEndpoint)
+
+	options := DocumentOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if err := c.httpClient.validate.Struct(options); err != nil {
+		return nil, err
+	}
+	if err := c.preflightTranslate(ctx, options.SourceLang, targetLang, options.Formality, options.GlossaryID); err != nil {
+		return nil, err
+	}
+
+	res := DocumentHandle{}
+	fields := options.fields(targetLang)
+	if err := c.httpClient.PostMultipartContext(ctx, url, fields, filename, document, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) GetDocumentStatus(handle DocumentHandle) (*DocumentStatus, error) {
+	return c.GetDocumentStatusContext(context.Background(), handle)
+}
+
+func (c *Client) GetDocumentStatusContext(ctx context.Context, handle DocumentHandle) (*DocumentStatus, error) {
+	url := c.endpointURL(documentStatusEndpoint, string(handle.DocumentID))
+
+	res := DocumentStatus{}
+	body := documentKeyRequest{DocumentKey: handle.DocumentKey}
+	if err := c.httpClient.PostJSONContext(ctx, url, body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// DownloadDocument write translated document into w, translation must be done
+// and document can be downloaded only once.
+func (c *Client) DownloadDocument(handle DocumentHandle, w io.Writer) error {
+	return c.DownloadDocumentContext(context.Background(), handle, w)
+}
+
+func (c *Client) DownloadDocumentContext(ctx context.Context, handle DocumentHandle, w io.Writer) error {
+	url := c.endpointURL(documentResultEndpoint, string(handle.DocumentID))
+
+	body := documentKeyRequest{DocumentKey: handle.DocumentKey}
+	return c.httpClient.PostJSONStreamContext(ctx, url, body, w)
+}
+
+// TranslateDocument upload document, wait until its translation is done then
+// write the translated document into w.
+func (c *Client) TranslateDocument(document io.Reader, filename string, w io.Writer, targetLang string, opts ...DocumentOption) (*DocumentStatus, error) {
+	return c.TranslateDocumentContext(context.Background(), document, filename, w, targetLang, opts...)
+}
+
+// TranslateDocumentContext is TranslateDocument with a context, waiting for
+// translation stop as soon as context is done.
+func (c *Client) TranslateDocumentContext(ctx context.Context, document io.Reader, filename string, w io.Writer, targetLang string, opts ...DocumentOption) (*DocumentStatus, error) {
+	handle, err := c.UploadDocumentContext(ctx, document, filename, targetLang, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		status, err := c.GetDocumentStatusContext(ctx, *handle)
+		if err != nil {
+			return nil, err
+		}
+
+		switch status.Status {
+		case DocumentStatusDone:
+			return status, c.DownloadDocumentContext(ctx, *handle, w)
+		case DocumentStatusError:
+			return status, &DocumentError{DocumentID: handle.DocumentID, Message: status.ErrorMessage}
+		}
+
+		timer := time.NewTimer(status.pollInterval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *DocumentStatus) pollInterval() time.Duration {
+	interval := time.Duration(s.SecondsRemaining) * time.Second
+	if interval < documentMinPollInterval {
+		return documentMinPollInterval
+	} else if interval > documentMaxPollInterval {
+		return documentMaxPollInterval
+	}
+
+	return interval
+}
+
+func (o *DocumentOptions) fields(targetLang string) url.Values {
+	fields := url.Values{}
+	fields.Set("target_lang", targetLang)
+	if o.SourceLang != "" {
+		fields.Set("source_lang", o.SourceLang)
+	}
+	if o.Formality != "" {
+		fields.Set("formality", string(o.Formality))
+	}
+	if o.GlossaryID != "" {
+		fields.Set("glossary_id", string(o.GlossaryID))
+	}
+	if o.OutputFormat != "" {
+		fields.Set("output_format", o.OutputFormat)
+	}
+
+	return fields
+}
@@ -0,0 +1,155 @@
+package deeplgo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client function UploadDocument
+// Function must send file and options in multipart form and decode handle
+func Test_Client_UploadDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, documentEndpoint)
+			assert.Nil(t, r.ParseMultipartForm(1<<20))
+			assert.Equal(t, "DE", r.FormValue("target_lang"))
+			assert.Equal(t, "EN", r.FormValue("source_lang"))
+			assert.Equal(t, "more", r.FormValue("formality"))
+			assert.Equal(t, "docx", r.FormValue("output_format"))
+			file, header, err := r.FormFile("file")
+			assert.Nil(t, err)
+			content, _ := io.ReadAll(file)
+			assert.Equal(t, "hello.txt", header.Filename)
+			assert.Equal(t, "Hello", string(content))
+			w.Write([]byte(`{"document_id":"04DE5AD98A02647D83285A36021911C6","document_key":"0CB0054F1C132C1625B392EADDA41CB754A742822F6877173029A6C487E7F60A"}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.UploadDocument(strings.NewReader("Hello"), "hello.txt", "DE",
+		WithDocumentSourceLang("EN"),
+		WithDocumentFormality(FormalityMore),
+		WithOutputFormat("docx"),
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &DocumentHandle{
+		DocumentID:  "04DE5AD98A02647D83285A36021911C6",
+		DocumentKey: "0CB0054F1C132C1625B392EADDA41CB754A742822F6877173029A6C487E7F60A",
+	}, res)
+}
+
+// Test Client function TranslateDocument on a document translated after one
+// status request.
+// Function must poll status until done and write result
+func Test_Client_TranslateDocument(t *testing.T) {
+	documentMinPollInterval = time.Millisecond
+	defer func() { documentMinPollInterval = time.Second }()
+
+	statusCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v2/document":
+				w.Write([]byte(`{"document_id":"ID","document_key":"KEY"}`))
+			case "/v2/document/ID":
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"document_key":"KEY"}`, string(body))
+				statusCalls++
+				if statusCalls == 1 {
+					w.Write([]byte(`{"document_id":"ID","status":"translating","seconds_remaining":0}`))
+				} else {
+					w.Write([]byte(`{"document_id":"ID","status":"done","billed_characters":5}`))
+				}
+			case "/v2/document/ID/result":
+				w.Write([]byte("Hallo"))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	out := &bytes.Buffer{}
+	res, err := c.TranslateDocument(strings.NewReader("Hello"), "hello.txt", out, "DE")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, statusCalls)
+	assert.Equal(t, 5, res.BilledCharacters)
+	assert.Equal(t, "Hallo", out.String())
+}
+
+// Test Client function TranslateDocument on a document DeepL fail to translate
+// Function must return DocumentError with message
+func Test_Client_TranslateDocumentError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v2/document":
+				w.Write([]byte(`{"document_id":"ID","document_key":"KEY"}`))
+			case "/v2/document/ID":
+				w.Write([]byte(`{"document_id":"ID","status":"error","error_message":"Invalid file"}`))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	out := &bytes.Buffer{}
+	_, err := c.TranslateDocument(strings.NewReader("Hello"), "hello.txt", out, "DE")
+
+	var docErr *DocumentError
+	assert.True(t, errors.As(err, &docErr))
+	assert.Equal(t, "Invalid file", docErr.Message)
+	assert.Empty(t, out.String())
+}
+
+// Test Client function TranslateDocumentContext canceled while waiting for
+// translation.
+// Function must stop polling and return error of context
+func Test_Client_TranslateDocumentContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v2/document":
+				w.Write([]byte(`{"document_id":"ID","document_key":"KEY"}`))
+			case "/v2/document/ID":
+				cancel()
+				w.Write([]byte(`{"document_id":"ID","status":"queued","seconds_remaining":60}`))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	out := &bytes.Buffer{}
+	start := time.Now()
+	_, err := c.TranslateDocumentContext(ctx, strings.NewReader("Hello"), "hello.txt", out, "DE")
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), documentMinPollInterval)
+}
@@ -0,0 +1,138 @@
+package deeplgo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// GlossaryID is the UUID given by DeepL to a glossary
+type GlossaryID string
+
+type Glossary struct {
+	GlossaryID   GlossaryID `json:"glossary_id" validate:"required"`
+	Name         string     `json:"name" validate:"required"`
+	Ready        bool       `json:"ready"`
+	SourceLang   string     `json:"source_lang" validate:"required"`
+	TargetLang   string     `json:"target_lang" validate:"required"`
+	CreationTime time.Time  `json:"creation_time"`
+	EntryCount   int        `json:"entry_count"`
+}
+
+type Glossaries struct {
+	Glossaries []Glossary `json:"glossaries" validate:"required,dive"`
+}
+
+type createGlossaryRequest struct {
+	Name          string `json:"name" validate:"required"`
+	SourceLang    string `json:"source_lang" validate:"required"`
+	TargetLang    string `json:"target_lang" validate:"required"`
+	Entries       string `json:"entries" validate:"required"`
+	EntriesFormat string `json:"entries_format"`
+}
+
+// CreateGlossary create a glossary named name translating each key of entries
+// from sourceLang into its value in targetLang. Entries are validated before
+// sending request.
+func (c *Client) CreateGlossary(name string, sourceLang string, targetLang string, entries GlossaryEntries) (*Glossary, error) {
+	return c.CreateGlossaryContext(context.Background(), name, sourceLang, targetLang, entries)
+}
+
+func (c *Client) CreateGlossaryContext(ctx context.Context, name string, sourceLang string, targetLang string, entries GlossaryEntries) (*Glossary, error) {
+	url := c.endpointURL(glossariesEndpoint)
+
+	encoded, err := entries.Encode(EntriesFormatTSV)
+	if err != nil {
+		return nil, err
+	}
+	body := createGlossaryRequest{
+		Name:          name,
+		SourceLang:    sourceLang,
+		TargetLang:    targetLang,
+		Entries:       encoded,
+		EntriesFormat: string(EntriesFormatTSV),
+	}
+	if err := c.httpClient.validate.Struct(body); err != nil {
+		return nil, err
+	}
+	if err := c.preflightGlossaryPair(ctx, sourceLang, targetLang); err != nil {
+		return nil, err
+	}
+
+	res := Glossary{}
+	if err := c.httpClient.PostJSONContext(ctx, url, body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) ListGlossaries() (*Glossaries, error) {
+	return c.ListGlossariesContext(context.Background())
+}
+
+func (c *Client) ListGlossariesContext(ctx context.Context) (*Glossaries, error) {
+	url := c.endpointURL(glossariesEndpoint)
+
+	res := Glossaries{}
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) GetGlossary(glossaryID GlossaryID) (*Glossary, error) {
+	return c.GetGlossaryContext(context.Background(), glossaryID)
+}
+
+func (c *Client) GetGlossaryContext(ctx context.Context, glossaryID GlossaryID) (*Glossary, error) {
+	url := c.endpointURL(glossaryEndpoint, string(glossaryID))
+
+	res := Glossary{}
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) DeleteGlossary(glossaryID GlossaryID) error {
+	return c.DeleteGlossaryContext(context.Background(), glossaryID)
+}
+
+func (c *Client) DeleteGlossaryContext(ctx context.Context, glossaryID GlossaryID) error {
+	url := c.endpointURL(glossaryEndpoint, string(glossaryID))
+
+	return c.httpClient.DeleteContext(ctx, url, nil)
+}
+
+// GetGlossaryEntries return entries of glossary, they are asked in TSV and
+// parsed according to format of response.
+func (c *Client) GetGlossaryEntries(glossaryID GlossaryID) (GlossaryEntries, error) {
+	return c.GetGlossaryEntriesContext(context.Background(), glossaryID)
+}
+
+func (c *Client) GetGlossaryEntriesContext(ctx context.Context, glossaryID GlossaryID) (GlossaryEntries, error) {
+	url := c.endpointURL(glossaryEntriesEndpoint, string(glossaryID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", EntriesFormatTSV.contentType())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseGlossaryEntries(string(data), entriesFormatOf(resp.Header.Get("Content-Type")))
+}
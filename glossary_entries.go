@@ -0,0 +1,187 @@
+package deeplgo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// EntriesFormat is a text format of glossary entries accepted by DeepL
+type EntriesFormat string
+
+const (
+	EntriesFormatTSV EntriesFormat = "tsv"
+	EntriesFormatCSV EntriesFormat = "csv"
+)
+
+// contentType return MIME type of format, used in header `Accept`
+func (f EntriesFormat) contentType() string {
+	if f == EntriesFormatCSV {
+		return "text/csv"
+	}
+
+	return "text/tab-separated-values"
+}
+
+// entriesFormatOf return format of a response with header `Content-Type`,
+// TSV when it is unknown.
+func entriesFormatOf(contentType string) EntriesFormat {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType == "text/csv" {
+		return EntriesFormatCSV
+	}
+
+	return EntriesFormatTSV
+}
+
+// GlossaryEntries map each source term of a glossary to its target term
+type GlossaryEntries map[string]string
+
+// GlossaryEntryError report an entry DeepL would reject. Line is the line of
+// the entry when entries are parsed, zero otherwise.
+type GlossaryEntryError struct {
+	Line   int
+	Source string
+	Reason string
+}
+
+func (e *GlossaryEntryError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("invalid glossary entry on line %d: %s", e.Line, e.Reason)
+	}
+
+	return fmt.Sprintf("invalid glossary entry %q: %s", e.Source, e.Reason)
+}
+
+// ParseGlossaryEntries read entries in format, one entry per line made of a
+// source and a target term. Entries are validated like by Validate and a
+// source term can't appear twice.
+func ParseGlossaryEntries(data string, format EntriesFormat) (GlossaryEntries, error) {
+	type record struct {
+		line   int
+		fields []string
+	}
+
+	records := []record{}
+	switch format {
+	case EntriesFormatTSV:
+		for i, line := range strings.Split(data, "\n") {
+			if line = strings.TrimSuffix(line, "\r"); line != "" {
+				records = append(records, record{line: i + 1, fields: strings.Split(line, "\t")})
+			}
+		}
+	case EntriesFormatCSV:
+		reader := csv.NewReader(strings.NewReader(data))
+		reader.FieldsPerRecord = -1
+		for {
+			fields, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			line, _ := reader.FieldPos(0)
+			records = append(records, record{line: line, fields: fields})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported glossary entries format: %s", format)
+	}
+
+	entries := GlossaryEntries{}
+	for _, r := range records {
+		if len(r.fields) != 2 {
+			return nil, &GlossaryEntryError{Line: r.line, Source: r.fields[0], Reason: fmt.Sprintf("expected 2 fields, got %d", len(r.fields))}
+		}
+		source, target := r.fields[0], r.fields[1]
+		if _, ok := entries[source]; ok {
+			return nil, &GlossaryEntryError{Line: r.line, Source: source, Reason: "duplicate source term"}
+		}
+		if reason := checkGlossaryEntry(source, target); reason != "" {
+			return nil, &GlossaryEntryError{Line: r.line, Source: source, Reason: reason}
+		}
+		entries[source] = target
+	}
+
+	return entries, nil
+}
+
+// Validate check every entry follow DeepL rules, terms must be valid UTF-8,
+// not empty, without tab, newline or other control character and without
+// leading or trailing whitespace.
+func (e GlossaryEntries) Validate() error {
+	for _, source := range e.sources() {
+		if reason := checkGlossaryEntry(source, e[source]); reason != "" {
+			return &GlossaryEntryError{Source: source, Reason: reason}
+		}
+	}
+
+	return nil
+}
+
+// Encode validate entries and write them in format sorted by source term, so
+// the same entries always give the same text.
+func (e GlossaryEntries) Encode(format EntriesFormat) (string, error) {
+	if err := e.Validate(); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	switch format {
+	case EntriesFormatTSV:
+		for _, source := range e.sources() {
+			b.WriteString(source + "\t" + e[source] + "\n")
+		}
+	case EntriesFormatCSV:
+		writer := csv.NewWriter(&b)
+		for _, source := range e.sources() {
+			writer.Write([]string{source, e[source]})
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unsupported glossary entries format: %s", format)
+	}
+
+	return b.String(), nil
+}
+
+func (e GlossaryEntries) sources() []string {
+	sources := make([]string, 0, len(e))
+	for source := range e {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	return sources
+}
+
+// checkGlossaryEntry return why DeepL would reject entry, or an empty string
+func checkGlossaryEntry(source string, target string) string {
+	for _, term := range []string{source, target} {
+		switch {
+		case term == "":
+			return "empty term"
+		case !utf8.ValidString(term):
+			return "term is not valid UTF-8"
+		case strings.IndexFunc(term, isForbiddenGlossaryRune) >= 0:
+			return fmt.Sprintf("term %q contain a tab, a newline or a control character", term)
+		case strings.TrimSpace(term) != term:
+			return fmt.Sprintf("term %q has leading or trailing whitespace", term)
+		}
+	}
+
+	return ""
+}
+
+// isForbiddenGlossaryRune report control characters and Unicode line and
+// paragraph separators
+func isForbiddenGlossaryRune(r rune) bool {
+	return unicode.IsControl(r) || r == '\u2028' || r == '\u2029'
+}
@@ -0,0 +1,110 @@
+package deeplgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test ParseGlossaryEntries with TSV and CSV
+// Both formats must give the same entries, CSV with quoted fields
+func Test_ParseGlossaryEntries(t *testing.T) {
+	tsv, err := ParseGlossaryEntries("Apple\tApfel\r\n\nHello, world\tHallo, Welt\n", EntriesFormatTSV)
+	assert.Nil(t, err)
+
+	csv, err := ParseGlossaryEntries("Apple,Apfel\n\"Hello, world\",\"Hallo, Welt\"\n", EntriesFormatCSV)
+	assert.Nil(t, err)
+
+	expected := GlossaryEntries{"Apple": "Apfel", "Hello, world": "Hallo, Welt"}
+	assert.Equal(t, expected, tsv)
+	assert.Equal(t, expected, csv)
+}
+
+// Test ParseGlossaryEntries with entries DeepL would reject
+// Function must return GlossaryEntryError with line of entry
+func Test_ParseGlossaryEntriesInvalid(t *testing.T) {
+	tests := []struct {
+		data   string
+		format EntriesFormat
+		line   int
+		reason string
+	}{
+		{"Apple\tApfel\nApple\tPomme", EntriesFormatTSV, 2, "duplicate source term"},
+		{"Apple\tApfel\n Pear\tBirne", EntriesFormatTSV, 2, `term " Pear" has leading or trailing whitespace`},
+		{"Apple\t\n", EntriesFormatTSV, 1, "empty term"},
+		{"Apple\t\xffpfel", EntriesFormatTSV, 1, "term is not valid UTF-8"},
+		{"Apple,Apfel\n\"Pear\nTree\",Birnbaum", EntriesFormatCSV, 2, `term "Pear\nTree" contain a tab, a newline or a control character`},
+		{"Apple,Apfel,Pomme", EntriesFormatCSV, 1, "expected 2 fields, got 3"},
+		{"Apple\tApfel\nPear", EntriesFormatTSV, 2, "expected 2 fields, got 1"},
+	}
+
+	for _, test := range tests {
+		res, err := ParseGlossaryEntries(test.data, test.format)
+
+		assert.Nil(t, res)
+		entryErr := &GlossaryEntryError{}
+		if assert.ErrorAs(t, err, &entryErr, test.data) {
+			assert.Equal(t, test.line, entryErr.Line, test.data)
+			assert.Equal(t, test.reason, entryErr.Reason, test.data)
+		}
+	}
+}
+
+// Test GlossaryEntries function Encode in TSV and CSV
+// Entries must be sorted and CSV fields quoted when needed
+func Test_GlossaryEntries_Encode(t *testing.T) {
+	entries := GlossaryEntries{"Pear": "Birne", "Apple, red": "Apfel, rot"}
+
+	tsv, err := entries.Encode(EntriesFormatTSV)
+	assert.Nil(t, err)
+	assert.Equal(t, "Apple, red\tApfel, rot\nPear\tBirne\n", tsv)
+
+	csv, err := entries.Encode(EntriesFormatCSV)
+	assert.Nil(t, err)
+	assert.Equal(t, "\"Apple, red\",\"Apfel, rot\"\nPear,Birne\n", csv)
+
+	_, err = GlossaryEntries{"Apple\t": "Apfel"}.Encode(EntriesFormatTSV)
+	assert.EqualError(t, err, `invalid glossary entry "Apple\t": term "Apple\t" contain a tab, a newline or a control character`)
+}
+
+// Test Client function CreateGlossary with an invalid entry
+// Function must return error without sending request
+func Test_Client_CreateGlossaryInvalidEntries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	_, err := c.CreateGlossary("My glossary", "en", "de", GlossaryEntries{"Apple ": "Apfel"})
+
+	assert.IsType(t, &GlossaryEntryError{}, err)
+	assert.Equal(t, 0, requests)
+}
+
+// Test Client function GetGlossaryEntries with a CSV response
+// Entries must be parsed according to header `Content-Type`
+func Test_Client_GetGlossaryEntriesCSV(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			w.Write([]byte("\"Apple, red\",\"Apfel, rot\"\n"))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.GetGlossaryEntries("def3a26b-3e84-45b3-84ae-0c0aaf3525f7")
+
+	assert.Nil(t, err)
+	assert.Equal(t, GlossaryEntries{"Apple, red": "Apfel, rot"}, res)
+}
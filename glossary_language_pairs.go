@@ -1,17 +1,26 @@
 package deeplgo
 
+import "context"
+
+// GlossaryLanguagePair is a source and target language of a glossary
+type GlossaryLanguagePair struct {
+	SourceLang string `json:"source_lang" validate:"required"`
+	TargetLang string `json:"target_lang" validate:"required"`
+}
+
 type GlossaryLanguagePairs struct {
-	SupportedLanguages []struct {
-		SourceLang string `json:"source_lang" validate:"required"`
-		TargetLang string `json:"target_lang" validate:"required"`
-	} `json:"supported_languages" validate:"required"`
+	SupportedLanguages []GlossaryLanguagePair `json:"supported_languages" validate:"required"`
 }
 
 func (c *Client) GetGlossaryLanguagePairs() (*GlossaryLanguagePairs, error) {
-	url := c.baseURL + glossaryLanguagePairsEndpoint
+	return c.GetGlossaryLanguagePairsContext(context.Background())
+}
+
+func (c *Client) GetGlossaryLanguagePairsContext(ctx context.Context) (*GlossaryLanguagePairs, error) {
+	url := c.endpointURL(glossaryLanguagePairsEndpoint)
 
 	res := GlossaryLanguagePairs{}
-	if err := c.httpClient.Get(url, []QueryParameter{}, &res); err != nil {
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
 		return nil, err
 	}
 
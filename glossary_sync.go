@@ -0,0 +1,122 @@
+package deeplgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GlossaryEntryChange is a source term whose target term changed
+type GlossaryEntryChange struct {
+	OldTarget string
+	NewTarget string
+}
+
+// GlossaryDiff is the difference between two versions of glossary entries
+type GlossaryDiff struct {
+	Added   GlossaryEntries
+	Removed GlossaryEntries
+	Changed map[string]GlossaryEntryChange
+}
+
+// Empty report if both versions have the same entries
+func (d GlossaryDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffGlossaryEntries return entries added, removed and changed from
+// oldEntries to newEntries.
+func DiffGlossaryEntries(oldEntries GlossaryEntries, newEntries GlossaryEntries) GlossaryDiff {
+	diff := GlossaryDiff{
+		Added:   GlossaryEntries{},
+		Removed: GlossaryEntries{},
+		Changed: map[string]GlossaryEntryChange{},
+	}
+	for source, target := range newEntries {
+		oldTarget, ok := oldEntries[source]
+		if !ok {
+			diff.Added[source] = target
+		} else if oldTarget != target {
+			diff.Changed[source] = GlossaryEntryChange{OldTarget: oldTarget, NewTarget: target}
+		}
+	}
+	for source, target := range oldEntries {
+		if _, ok := newEntries[source]; !ok {
+			diff.Removed[source] = target
+		}
+	}
+
+	return diff
+}
+
+// GlossarySyncResult describe what SyncGlossary did
+type GlossarySyncResult struct {
+	// GlossaryID is the glossary holding entries after sync
+	GlossaryID GlossaryID
+	// Created is false when existing glossary was already up to date
+	Created bool
+	// Deleted are glossaries superseded by the created one
+	Deleted []GlossaryID
+	// Diff is the difference from entries of existing glossary, every entry
+	// is added when there was none.
+	Diff GlossaryDiff
+}
+
+// SyncGlossary make glossary named name for pair hold exactly entries. As a
+// glossary can't be edited, it is recreated only when entries differ from
+// the most recent glossary with this name and pair, then previous glossaries
+// with this name and pair are deleted. When a deletion fail, result is
+// returned with error as new glossary is already created.
+func (c *Client) SyncGlossary(name string, pair GlossaryLanguagePair, entries GlossaryEntries) (*GlossarySyncResult, error) {
+	return c.SyncGlossaryContext(context.Background(), name, pair, entries)
+}
+
+func (c *Client) SyncGlossaryContext(ctx context.Context, name string, pair GlossaryLanguagePair, entries GlossaryEntries) (*GlossarySyncResult, error) {
+	if err := entries.Validate(); err != nil {
+		return nil, err
+	}
+
+	glossaries, err := c.ListGlossariesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := []Glossary{}
+	for _, glossary := range glossaries.Glossaries {
+		if glossary.Name == name && strings.EqualFold(glossary.SourceLang, pair.SourceLang) && strings.EqualFold(glossary.TargetLang, pair.TargetLang) {
+			existing = append(existing, glossary)
+		}
+	}
+	sort.SliceStable(existing, func(i, j int) bool {
+		return existing[i].CreationTime.After(existing[j].CreationTime)
+	})
+
+	oldEntries := GlossaryEntries{}
+	if len(existing) > 0 {
+		if oldEntries, err = c.GetGlossaryEntriesContext(ctx, existing[0].GlossaryID); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &GlossarySyncResult{Diff: DiffGlossaryEntries(oldEntries, entries)}
+	if len(existing) > 0 && res.Diff.Empty() {
+		res.GlossaryID = existing[0].GlossaryID
+		return res, nil
+	}
+
+	glossary, err := c.CreateGlossaryContext(ctx, name, pair.SourceLang, pair.TargetLang, entries)
+	if err != nil {
+		return nil, err
+	}
+	res.GlossaryID = glossary.GlossaryID
+	res.Created = true
+
+	for _, old := range existing {
+		if err := c.DeleteGlossaryContext(ctx, old.GlossaryID); err != nil {
+			return res, fmt.Errorf("delete superseded glossary %s: %w", old.GlossaryID, err)
+		}
+		res.Deleted = append(res.Deleted, old.GlossaryID)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,67 @@
+package deeplgo
+
+import (
+	"testing"
+
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test DiffGlossaryEntries
+// Entries must be sorted in added, removed and changed
+func Test_DiffGlossaryEntries(t *testing.T) {
+	diff := DiffGlossaryEntries(
+		GlossaryEntries{"Apple": "Apfel", "Pear": "Birne", "Plum": "Pflaume"},
+		GlossaryEntries{"Apple": "Apfel", "Pear": "Birnen", "Cherry": "Kirsche"},
+	)
+
+	assert.Equal(t, GlossaryEntries{"Cherry": "Kirsche"}, diff.Added)
+	assert.Equal(t, GlossaryEntries{"Plum": "Pflaume"}, diff.Removed)
+	assert.Equal(t, map[string]GlossaryEntryChange{"Pear": {OldTarget: "Birne", NewTarget: "Birnen"}}, diff.Changed)
+	assert.False(t, diff.Empty())
+	assert.True(t, DiffGlossaryEntries(GlossaryEntries{"Apple": "Apfel"}, GlossaryEntries{"Apple": "Apfel"}).Empty())
+}
+
+// Test Client function SyncGlossary from creation to update
+// Glossary must be recreated only when entries change and previous one
+// deleted
+func Test_Client_SyncGlossary(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()))
+	pair := GlossaryLanguagePair{SourceLang: "EN", TargetLang: "DE"}
+
+	first, err := c.SyncGlossary("terms", pair, GlossaryEntries{"Apple": "Apfel"})
+	assert.Nil(t, err)
+	assert.True(t, first.Created)
+	assert.Equal(t, GlossaryEntries{"Apple": "Apfel"}, first.Diff.Added)
+
+	unchanged, err := c.SyncGlossary("terms", pair, GlossaryEntries{"Apple": "Apfel"})
+	assert.Nil(t, err)
+	assert.False(t, unchanged.Created)
+	assert.Equal(t, first.GlossaryID, unchanged.GlossaryID)
+
+	changed, err := c.SyncGlossary("terms", pair, GlossaryEntries{"Apple": "Apfel", "Pear": "Birne"})
+	assert.Nil(t, err)
+	assert.True(t, changed.Created)
+	assert.NotEqual(t, first.GlossaryID, changed.GlossaryID)
+	assert.Equal(t, []GlossaryID{first.GlossaryID}, changed.Deleted)
+	assert.Equal(t, GlossaryEntries{"Pear": "Birne"}, changed.Diff.Added)
+
+	glossaries, err := c.ListGlossaries()
+	assert.Nil(t, err)
+	assert.Len(t, glossaries.Glossaries, 1)
+}
+
+// Test Client function SyncGlossary with invalid entries
+// Function must return error without sending request
+func Test_Client_SyncGlossaryInvalidEntries(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()))
+
+	_, err := c.SyncGlossary("terms", GlossaryLanguagePair{SourceLang: "EN", TargetLang: "DE"}, GlossaryEntries{"Apple": ""})
+
+	assert.IsType(t, &GlossaryEntryError{}, err)
+	assert.Equal(t, 0, server.Requests())
+}
@@ -0,0 +1,111 @@
+package deeplgo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client function CreateGlossary
+// Function must send entries as sorted TSV and decode created glossary
+func Test_Client_CreateGlossary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.Method, http.MethodPost)
+			assert.Equal(t, r.URL.Path, glossariesEndpoint)
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"name":"My glossary",
+				"source_lang":"en",
+				"target_lang":"de",
+				"entries":"Apple\tApfel\nPear\tBirne\n",
+				"entries_format":"tsv"
+			}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{
+				"glossary_id":"def3a26b-3e84-45b3-84ae-0c0aaf3525f7",
+				"name":"My glossary",
+				"ready":true,
+				"source_lang":"en",
+				"target_lang":"de",
+				"creation_time":"2021-08-03T14:16:18.329Z",
+				"entry_count":2
+			}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.CreateGlossary("My glossary", "en", "de", map[string]string{
+		"Pear":  "Birne",
+		"Apple": "Apfel",
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, GlossaryID("def3a26b-3e84-45b3-84ae-0c0aaf3525f7"), res.GlossaryID)
+	assert.Equal(t, 2, res.EntryCount)
+}
+
+// Test Client function GetGlossaryEntries
+// Function must ask TSV format and decode entries
+func Test_Client_GetGlossaryEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, "/v2/glossaries/def3a26b-3e84-45b3-84ae-0c0aaf3525f7/entries")
+			assert.Equal(t, r.Header.Get("Accept"), "text/tab-separated-values")
+			w.Write([]byte("Apple\tApfel\r\nPear\tBirne"))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.GetGlossaryEntries("def3a26b-3e84-45b3-84ae-0c0aaf3525f7")
+
+	assert.Nil(t, err)
+	assert.Equal(t, GlossaryEntries{"Apple": "Apfel", "Pear": "Birne"}, res)
+}
+
+// Test Client function GetGlossaryEntries with malformed TSV
+// Function must return error with line number
+func Test_Client_GetGlossaryEntriesMalformed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("Apple\tApfel\nPear"))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.GetGlossaryEntries("def3a26b-3e84-45b3-84ae-0c0aaf3525f7")
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "invalid glossary entry on line 2: expected 2 fields, got 1")
+}
+
+// Test Client function DeleteGlossary with an ID including reserved characters
+// Function must escape ID as a single path segment
+func Test_Client_DeleteGlossaryEscapeID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.EscapedPath(), "/v2/glossaries/..%2Fusage%3Fx")
+			w.WriteHeader(http.StatusNoContent)
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	err := c.DeleteGlossary("../usage?x")
+
+	assert.Nil(t, err)
+}
@@ -1,21 +1,27 @@
 package deeplgo
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 )
 
 type HTTPClient struct {
-	client   *http.Client
-	validate *validator.Validate
-	apiKey   string
+	client      *http.Client
+	validate    *validator.Validate
+	apiKey      string
+	userAgent   string
+	retryPolicy RetryPolicy
 }
 
 type QueryParameter struct {
@@ -29,44 +35,109 @@ func NewHTTPClient(apiKey string) *HTTPClient {
 		client: &http.Client{
 			Timeout: time.Minute,
 		},
-		validate: validator.New(),
+		validate:    validator.New(),
+		retryPolicy: DefaultRetryPolicy(),
 	}
 }
 
-// ProcessError check if HTTP status code is in list of status code known then
-// if request return data try to parse it. And if HTTP status is unknown return
-// status code not managed.
+func (hc *HTTPClient) GetRetryPolicy() RetryPolicy {
+	return hc.retryPolicy
+}
+
+func (hc *HTTPClient) SetRetryPolicy(retryPolicy RetryPolicy) {
+	hc.retryPolicy = retryPolicy
+}
+
+// ProcessError build an APIError from response, if HTTP status code is in list
+// of status code known error unwraps to the matching sentinel error, if
+// request return data message and detail are parsed from it.
 func (hc *HTTPClient) ProcessError(resp *http.Response) error {
-	if err, ok := errCodes[resp.StatusCode]; ok {
-		var errResp ErrorMessage
-		if errDec := json.NewDecoder(resp.Body).Decode(&errResp); errDec == nil {
-			errText := err.Error()
-			if errResp.Message != nil {
-				errText += ", message : " + *errResp.Message
-			}
-			if errResp.Detail != nil {
-				errText += ", detail : " + *errResp.Detail
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header),
+		RequestID:  requestID(resp.Header),
+		err:        errCodes[resp.StatusCode],
+	}
+
+	var errResp ErrorMessage
+	if errDec := json.NewDecoder(resp.Body).Decode(&errResp); errDec == nil {
+		if errResp.Message != nil {
+			apiErr.Message = *errResp.Message
+		}
+		if errResp.Detail != nil {
+			apiErr.Detail = *errResp.Detail
+		}
+	}
+
+	return apiErr
+}
+
+// Do Take http.Request to send it with API Key in header `Authorization` and
+// return response when HTTP status code is a success, caller must close body.
+// Otherwise error is build from response by ProcessError, or is a
+// TransportError when there is no response. Request is sent
+// again according to retry policy, unless its body can't be read twice, and
+// is canceled with its context. A POST or PATCH failing on network is sent
+// again only when it did not reach server, see RetryPolicy.
+func (hc *HTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", fmt.Sprintf("DeepL-Auth-Key %s", hc.apiKey))
+	if hc.userAgent != "" {
+		req.Header.Set("User-Agent", hc.userAgent)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := hc.send(req)
+		if err == nil || !hc.retryPolicy.shouldRetry(attempt, req.Method, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		timer := time.NewTimer(hc.retryPolicy.delay(attempt, err))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, errBody := req.GetBody()
+			if errBody != nil {
+				return nil, errBody
 			}
-			return errors.New(errText)
+			req = req.Clone(req.Context())
+			req.Body = body
 		}
-		return err
 	}
+}
 
-	return fmt.Errorf("unknown error, status code: %d", resp.StatusCode)
+func (hc *HTTPClient) send(req *http.Request) (*http.Response, error) {
+	resp, err := hc.client.Do(req)
+	if err != nil {
+		return nil, newTransportError(err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		return nil, hc.ProcessError(resp)
+	}
+
+	return resp, nil
 }
 
 // SendRequest Take http.Request to send it and manage errors from several
 // source and set API Key in header `Authorization` for current request.
+// When dataInterface is nil or response has no content, body is ignored.
 func (hc *HTTPClient) SendRequest(req *http.Request, dataInterface interface{}) error {
-	req.Header.Set("Authorization", fmt.Sprintf("DeepL-Auth-Key %s", hc.apiKey))
-
-	var err error
-	resp, _ := hc.client.Do(req)
-	if resp != nil {
-		defer resp.Body.Close()
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return hc.ProcessError(resp)
+	defer resp.Body.Close()
+
+	if dataInterface == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
 	}
 
 	if err = json.NewDecoder(resp.Body).Decode(dataInterface); err != nil {
@@ -90,7 +161,15 @@ func (hc *HTTPClient) SendRequest(req *http.Request, dataInterface interface{})
 
 // Get Wrap request creation and SendRequest call in HTTP GET context
 func (hc *HTTPClient) Get(url string, queryParameters []QueryParameter, dataInterface interface{}) error {
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	return hc.GetContext(context.Background(), url, queryParameters, dataInterface)
+}
+
+// GetContext is Get with a context to cancel request
+func (hc *HTTPClient) GetContext(ctx context.Context, url string, queryParameters []QueryParameter, dataInterface interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
 
 	if len(queryParameters) > 0 {
 		currentQ := req.URL.Query()
@@ -101,24 +180,177 @@ func (hc *HTTPClient) Get(url string, queryParameters []QueryParameter, dataInte
 		req.URL.RawQuery = currentQ.Encode()
 	}
 
+	return hc.SendRequest(req, dataInterface)
+}
+
+// Post Wrap request creation and SendRequest call in HTTP POST context
+func (hc *HTTPClient) Post(url string, data io.Reader, dataInterface interface{}) error {
+	return hc.PostContext(context.Background(), url, data, dataInterface)
+}
+
+// PostContext is Post with a context to cancel request
+func (hc *HTTPClient) PostContext(ctx context.Context, url string, data io.Reader, dataInterface interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, data)
 	if err != nil {
 		return err
-	} else if err := hc.SendRequest(req, dataInterface); err != nil {
+	}
+
+	return hc.SendRequest(req, dataInterface)
+}
+
+// Delete Wrap request creation and SendRequest call in HTTP DELETE context
+func (hc *HTTPClient) Delete(url string, dataInterface interface{}) error {
+	return hc.DeleteContext(context.Background(), url, dataInterface)
+}
+
+// DeleteContext is Delete with a context to cancel request
+func (hc *HTTPClient) DeleteContext(ctx context.Context, url string, dataInterface interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return hc.SendRequest(req, dataInterface)
 }
 
-// Post Wrap request creation and SendRequest call in HTTP POST context
-func (hc *HTTPClient) Post(url string, data io.Reader, dataInterface interface{}) error {
-	req, err := http.NewRequest(http.MethodPost, url, data)
+// PostJSON Encode data in JSON and send it as body of HTTP POST request with
+// header `Content-Type` set accordingly
+func (hc *HTTPClient) PostJSON(url string, data interface{}, dataInterface interface{}) error {
+	return hc.PostJSONContext(context.Background(), url, data, dataInterface)
+}
+
+// PostJSONContext is PostJSON with a context to cancel request
+func (hc *HTTPClient) PostJSONContext(ctx context.Context, url string, data interface{}, dataInterface interface{}) error {
+	req, err := newJSONRequest(ctx, http.MethodPost, url, data)
+	if err != nil {
+		return err
+	}
+
+	return hc.SendRequest(req, dataInterface)
+}
+
+// PatchJSON Encode data in JSON and send it as body of HTTP PATCH request
+func (hc *HTTPClient) PatchJSON(url string, data interface{}, dataInterface interface{}) error {
+	return hc.PatchJSONContext(context.Background(), url, data, dataInterface)
+}
 
+// PatchJSONContext is PatchJSON with a context to cancel request
+func (hc *HTTPClient) PatchJSONContext(ctx context.Context, url string, data interface{}, dataInterface interface{}) error {
+	req, err := newJSONRequest(ctx, http.MethodPatch, url, data)
 	if err != nil {
 		return err
-	} else if err := hc.SendRequest(req, dataInterface); err != nil {
+	}
+
+	return hc.SendRequest(req, dataInterface)
+}
+
+// PutJSON Encode data in JSON and send it as body of HTTP PUT request
+func (hc *HTTPClient) PutJSON(url string, data interface{}, dataInterface interface{}) error {
+	return hc.PutJSONContext(context.Background(), url, data, dataInterface)
+}
+
+// PutJSONContext is PutJSON with a context to cancel request
+func (hc *HTTPClient) PutJSONContext(ctx context.Context, url string, data interface{}, dataInterface interface{}) error {
+	req, err := newJSONRequest(ctx, http.MethodPut, url, data)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return hc.SendRequest(req, dataInterface)
+}
+
+// PostMultipart Send fields and file content in a multipart form as body of
+// HTTP POST request. File is read entirely before sending request.
+func (hc *HTTPClient) PostMultipart(url string, fields url.Values, filename string, file io.Reader, dataInterface interface{}) error {
+	return hc.PostMultipartContext(context.Background(), url, fields, filename, file, dataInterface)
+}
+
+// PostMultipartContext is PostMultipart with a context to cancel request
+func (hc *HTTPClient) PostMultipartContext(ctx context.Context, url string, fields url.Values, filename string, file io.Reader, dataInterface interface{}) error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range fields[key] {
+			if err := writer.WriteField(key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return hc.SendRequest(req, dataInterface)
+}
+
+// PostJSONStream Encode data like PostJSON but copy response body as is into
+// w, it is meant for binary responses which can't be decoded.
+func (hc *HTTPClient) PostJSONStream(url string, data interface{}, w io.Writer) error {
+	return hc.PostJSONStreamContext(context.Background(), url, data, w)
+}
+
+// PostJSONStreamContext is PostJSONStream with a context to cancel request
+// and copy of response body
+func (hc *HTTPClient) PostJSONStreamContext(ctx context.Context, url string, data interface{}, w io.Writer) error {
+	req, err := newJSONRequest(ctx, http.MethodPost, url, data)
+	if err != nil {
+		return err
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func newJSONRequest(ctx context.Context, method string, url string, data interface{}) (*http.Request, error) {
+	body, err := encodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// encodeJSON is json.Marshal without escaping of HTML characters, as texts
+// with tags would be larger for nothing.
+func encodeJSON(data interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(data); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
 }
@@ -2,11 +2,14 @@ package deeplgo
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
+	"log"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -23,7 +26,7 @@ func Test_HTTPClient_ProcessErrorBasic(t *testing.T) {
 		Body:       body,
 	})
 
-	assert.ErrorIs(t, err, errRequestResourceNotFound)
+	assert.ErrorIs(t, err, ErrRequestResourceNotFound)
 }
 
 // Test HTTPClient function ProcessError with data in response (var json)
@@ -40,7 +43,7 @@ func Test_HTTPClient_ProcessErrorWithMessage(t *testing.T) {
 		Body:       body,
 	})
 
-	errTarget := errRequestResourceNotFound.Error() + ", message : Message test"
+	errTarget := ErrRequestResourceNotFound.Error() + ", message : Message test"
 	assert.EqualError(t, err, errTarget)
 }
 
@@ -58,7 +61,7 @@ func Test_HTTPClient_ProcessErrorWithMessageAndDetail(t *testing.T) {
 		Body:       body,
 	})
 
-	errTarget := errRequestResourceNotFound.Error() + ", message : Message test, detail : Detail test"
+	errTarget := ErrRequestResourceNotFound.Error() + ", message : Message test, detail : Detail test"
 	assert.EqualError(t, err, errTarget)
 }
 
@@ -77,6 +80,32 @@ func Test_HTTPClient_ProcessErrorWithUnknownError(t *testing.T) {
 	assert.EqualError(t, err, "unknown error, status code: 418")
 }
 
+// Test HTTPClient function ProcessError with headers and data in response
+// Function must return an APIError unwrapping to sentinel error
+func Test_HTTPClient_ProcessErrorAPIError(t *testing.T) {
+	json := `{"message":"Quota exceeded"}`
+	body := io.NopCloser(bytes.NewReader([]byte(json)))
+
+	hc := NewHTTPClient("NO_API_KEY")
+
+	header := http.Header{}
+	header.Set("Retry-After", "30")
+	header.Set("X-Trace-Id", "a1b2c3")
+	err := hc.ProcessError(&http.Response{
+		StatusCode: 456,
+		Header:     header,
+		Body:       body,
+	})
+
+	var apiErr *APIError
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 456, apiErr.StatusCode)
+	assert.Equal(t, "Quota exceeded", apiErr.Message)
+	assert.Equal(t, 30*time.Second, apiErr.RetryAfter)
+	assert.Equal(t, "a1b2c3", apiErr.RequestID)
+}
+
 type TestStruct struct {
 	Page  int      `json:"page" validate:"required"`
 	Count int      `json:"count" validate:"required"`
@@ -355,3 +384,167 @@ func Test_HTTPClient_PostErrorNested(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
 }
+
+// Test HTTPClient function PostJSON with Json object
+// Function must send encoded body with header Content-Type and decode response
+func Test_HTTPClient_PostJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.Header.Get("Content-Type"), "application/json")
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"title":"Apple","description":"Forbidden fruit"}`, string(body))
+			w.Write([]byte(`{"page":1,"count":1,"data":["Apple"]}`))
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+
+	data := struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}{Title: "Apple", Description: "Forbidden fruit"}
+	res := TestStruct{}
+	err := hc.PostJSON(server.URL, data, &res)
+
+	assert.Nil(t, err)
+	assert.Equal(t, TestStruct{
+		Page:  1,
+		Count: 1,
+		Data:  []string{"Apple"},
+	}, res)
+}
+
+// Test HTTPClient function DELETE with response without content
+// Function must return no error
+func Test_HTTPClient_Delete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.Method, http.MethodDelete)
+			w.WriteHeader(http.StatusNoContent)
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+
+	err := hc.Delete(server.URL, nil)
+
+	assert.Nil(t, err)
+}
+
+// Test HTTPClient function DELETE with error
+// Function must return error build by ProcessError
+func Test_HTTPClient_DeleteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+
+	err := hc.Delete(server.URL, nil)
+
+	assert.ErrorIs(t, err, ErrRequestResourceNotFound)
+}
+
+// Test HTTPClient function PostJSONStream with binary response
+// Function must copy response body as is
+func Test_HTTPClient_PostJSONStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte{0x50, 0x4b, 0x03, 0x04})
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+
+	out := &bytes.Buffer{}
+	err := hc.PostJSONStream(server.URL, map[string]string{}, out)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x50, 0x4b, 0x03, 0x04}, out.Bytes())
+}
+
+// Test HTTPClient function GetContext with a deadline shorter than response
+// Function must return error of context
+func Test_HTTPClient_GetContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	res := TestStruct{}
+	err := hc.GetContext(ctx, server.URL, []QueryParameter{}, &res)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// Test HTTPClient function Get on a closed server
+// Function must return TransportError of kind connection refused
+func Test_HTTPClient_TransportErrorConnectionRefused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {},
+	))
+	server.Close()
+
+	hc := NewHTTPClient("NO_API_KEY")
+	hc.SetRetryPolicy(NoRetryPolicy())
+
+	err := hc.Get(server.URL, []QueryParameter{}, &TestStruct{})
+
+	var transportErr *TransportError
+	assert.True(t, errors.As(err, &transportErr))
+	assert.Equal(t, TransportErrorConnectionRefused, transportErr.Kind)
+}
+
+// Test HTTPClient function Get on a server slower than client timeout
+// Function must return TransportError of kind timeout
+func Test_HTTPClient_TransportErrorTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+	hc.SetRetryPolicy(NoRetryPolicy())
+	hc.client.Timeout = 10 * time.Millisecond
+
+	err := hc.Get(server.URL, []QueryParameter{}, &TestStruct{})
+
+	var transportErr *TransportError
+	assert.True(t, errors.As(err, &transportErr))
+	assert.Equal(t, TransportErrorTimeout, transportErr.Kind)
+}
+
+// Test HTTPClient function Get on a server with untrusted certificate
+// Function must return TransportError of kind TLS without retrying
+func Test_HTTPClient_TransportErrorTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {},
+	))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+
+	start := time.Now()
+	err := hc.Get(server.URL, []QueryParameter{}, &TestStruct{})
+
+	var transportErr *TransportError
+	assert.True(t, errors.As(err, &transportErr))
+	assert.Equal(t, TransportErrorTLS, transportErr.Kind)
+	assert.Less(t, time.Since(start), hc.GetRetryPolicy().BaseDelay)
+}
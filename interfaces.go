@@ -0,0 +1,43 @@
+package deeplgo
+
+import (
+	"context"
+	"io"
+)
+
+// Translator translate texts, it is implemented by Client and by decorators
+// like CachedTranslator.
+type Translator interface {
+	TranslateTextContext(ctx context.Context, texts []string, targetLang string, opts ...TranslateOption) (*Translations, error)
+}
+
+// GlossaryManager manage glossaries and their entries
+type GlossaryManager interface {
+	CreateGlossaryContext(ctx context.Context, name string, sourceLang string, targetLang string, entries GlossaryEntries) (*Glossary, error)
+	ListGlossariesContext(ctx context.Context) (*Glossaries, error)
+	GetGlossaryContext(ctx context.Context, glossaryID GlossaryID) (*Glossary, error)
+	DeleteGlossaryContext(ctx context.Context, glossaryID GlossaryID) error
+	GetGlossaryEntriesContext(ctx context.Context, glossaryID GlossaryID) (GlossaryEntries, error)
+}
+
+// DocumentTranslator translate documents
+type DocumentTranslator interface {
+	UploadDocumentContext(ctx context.Context, document io.Reader, filename string, targetLang string, opts ...DocumentOption) (*DocumentHandle, error)
+	GetDocumentStatusContext(ctx context.Context, handle DocumentHandle) (*DocumentStatus, error)
+	DownloadDocumentContext(ctx context.Context, handle DocumentHandle, w io.Writer) error
+	TranslateDocumentContext(ctx context.Context, document io.Reader, filename string, w io.Writer, targetLang string, opts ...DocumentOption) (*DocumentStatus, error)
+}
+
+// UsageReporter report characters used and available
+type UsageReporter interface {
+	GetUsageContext(ctx context.Context) (*Usage, error)
+}
+
+var (
+	_ Translator         = (*Client)(nil)
+	_ Translator         = (*CachedTranslator)(nil)
+	_ Translator         = (*BudgetGuard)(nil)
+	_ GlossaryManager    = (*Client)(nil)
+	_ DocumentTranslator = (*Client)(nil)
+	_ UsageReporter      = (*Client)(nil)
+)
@@ -0,0 +1,181 @@
+package deeplgo
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// LanguageCode is a language as written by DeepL, a base language like EN
+// optionally followed by a regional variant like EN-US.
+type LanguageCode string
+
+const (
+	LanguageArabic              LanguageCode = "AR"
+	LanguageBulgarian           LanguageCode = "BG"
+	LanguageCzech               LanguageCode = "CS"
+	LanguageDanish              LanguageCode = "DA"
+	LanguageGerman              LanguageCode = "DE"
+	LanguageGreek               LanguageCode = "EL"
+	LanguageEnglish             LanguageCode = "EN"
+	LanguageEnglishBritish      LanguageCode = "EN-GB"
+	LanguageEnglishAmerican     LanguageCode = "EN-US"
+	LanguageSpanish             LanguageCode = "ES"
+	LanguageEstonian            LanguageCode = "ET"
+	LanguageFinnish             LanguageCode = "FI"
+	LanguageFrench              LanguageCode = "FR"
+	LanguageHungarian           LanguageCode = "HU"
+	LanguageIndonesian          LanguageCode = "ID"
+	LanguageItalian             LanguageCode = "IT"
+	LanguageJapanese            LanguageCode = "JA"
+	LanguageKorean              LanguageCode = "KO"
+	LanguageLithuanian          LanguageCode = "LT"
+	LanguageLatvian             LanguageCode = "LV"
+	LanguageNorwegian           LanguageCode = "NB"
+	LanguageDutch               LanguageCode = "NL"
+	LanguagePolish              LanguageCode = "PL"
+	LanguagePortuguese          LanguageCode = "PT"
+	LanguagePortugueseBrazilian LanguageCode = "PT-BR"
+	LanguagePortugueseEuropean  LanguageCode = "PT-PT"
+	LanguageRomanian            LanguageCode = "RO"
+	LanguageRussian             LanguageCode = "RU"
+	LanguageSlovak              LanguageCode = "SK"
+	LanguageSlovenian           LanguageCode = "SL"
+	LanguageSwedish             LanguageCode = "SV"
+	LanguageTurkish             LanguageCode = "TR"
+	LanguageUkrainian           LanguageCode = "UK"
+	LanguageChinese             LanguageCode = "ZH"
+	LanguageChineseSimplified   LanguageCode = "ZH-HANS"
+	LanguageChineseTraditional  LanguageCode = "ZH-HANT"
+)
+
+// Variant used as target for a base language which DeepL only accept with a
+// regional variant.
+var defaultTargetVariants = map[LanguageCode]LanguageCode{
+	LanguageEnglish:    LanguageEnglishAmerican,
+	LanguagePortuguese: LanguagePortugueseEuropean,
+	LanguageChinese:    LanguageChineseSimplified,
+}
+
+// Normalize return code in upper case with `-` between language and variant,
+// so "en_us" give EN-US.
+func (l LanguageCode) Normalize() LanguageCode {
+	return LanguageCode(strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(string(l)), "_", "-")))
+}
+
+// Base return language without regional variant, EN for EN-US
+func (l LanguageCode) Base() LanguageCode {
+	base, _, _ := strings.Cut(string(l.Normalize()), "-")
+
+	return LanguageCode(base)
+}
+
+// AsSource return code as expected for a source language, source languages
+// have no regional variant.
+func (l LanguageCode) AsSource() LanguageCode {
+	return l.Base()
+}
+
+// AsTarget return code as expected for a target language, a base language
+// having regional variants get its default one, EN give EN-US.
+func (l LanguageCode) AsTarget() LanguageCode {
+	code := l.Normalize()
+	if variant, ok := defaultTargetVariants[code]; ok {
+		return variant
+	}
+
+	return code
+}
+
+// LanguageSet index source and target languages supported by DeepL to look
+// up their capabilities. Codes given to its methods are normalized.
+type LanguageSet struct {
+	source map[LanguageCode]Language
+	target map[LanguageCode]Language
+}
+
+func NewLanguageSet(source Languages, target Languages) *LanguageSet {
+	s := &LanguageSet{
+		source: map[LanguageCode]Language{},
+		target: map[LanguageCode]Language{},
+	}
+	for _, language := range source {
+		s.source[language.Language.Normalize()] = language
+	}
+	for _, language := range target {
+		s.target[language.Language.Normalize()] = language
+	}
+
+	return s
+}
+
+// GetLanguageSet return source and target languages currently supported by
+// DeepL.
+func (c *Client) GetLanguageSet() (*LanguageSet, error) {
+	return c.GetLanguageSetContext(context.Background())
+}
+
+func (c *Client) GetLanguageSetContext(ctx context.Context) (*LanguageSet, error) {
+	source, err := c.GetSourceLanguagesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := c.GetTargetLanguagesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLanguageSet(*source, *target), nil
+}
+
+func (s *LanguageSet) Source(code LanguageCode) (Language, bool) {
+	language, ok := s.source[code.Normalize()]
+
+	return language, ok
+}
+
+func (s *LanguageSet) Target(code LanguageCode) (Language, bool) {
+	language, ok := s.target[code.Normalize()]
+
+	return language, ok
+}
+
+func (s *LanguageSet) IsValidSource(code LanguageCode) bool {
+	_, ok := s.Source(code)
+
+	return ok
+}
+
+func (s *LanguageSet) IsValidTarget(code LanguageCode) bool {
+	_, ok := s.Target(code)
+
+	return ok
+}
+
+// SupportsFormality report if target language accept a formality, it is
+// false for an unknown language.
+func (s *LanguageSet) SupportsFormality(code LanguageCode) bool {
+	language, ok := s.Target(code)
+
+	return ok && language.SupportsFormality
+}
+
+// SourceCodes return codes of source languages sorted
+func (s *LanguageSet) SourceCodes() []LanguageCode {
+	return sortedCodes(s.source)
+}
+
+// TargetCodes return codes of target languages sorted
+func (s *LanguageSet) TargetCodes() []LanguageCode {
+	return sortedCodes(s.target)
+}
+
+func sortedCodes(languages map[LanguageCode]Language) []LanguageCode {
+	codes := make([]LanguageCode, 0, len(languages))
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+
+	return codes
+}
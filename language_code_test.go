@@ -0,0 +1,57 @@
+package deeplgo
+
+import (
+	"testing"
+
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test LanguageCode normalization for source and target
+// Case and separator must be normalized and variants added or removed
+func Test_LanguageCode_Normalize(t *testing.T) {
+	tests := []struct {
+		code      LanguageCode
+		normalize LanguageCode
+		source    LanguageCode
+		target    LanguageCode
+	}{
+		{"en", "EN", "EN", "EN-US"},
+		{"en_gb", "EN-GB", "EN", "EN-GB"},
+		{" pt-br ", "PT-BR", "PT", "PT-BR"},
+		{"pt", "PT", "PT", "PT-PT"},
+		{"zh", "ZH", "ZH", "ZH-HANS"},
+		{"zh-Hant", "ZH-HANT", "ZH", "ZH-HANT"},
+		{"de", "DE", "DE", "DE"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.normalize, test.code.Normalize(), test.code)
+		assert.Equal(t, test.source, test.code.AsSource(), test.code)
+		assert.Equal(t, test.target, test.code.AsTarget(), test.code)
+	}
+}
+
+// Test Client function GetLanguageSet
+// Set must look up languages and their capabilities by normalized code
+func Test_Client_GetLanguageSet(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()))
+
+	languages, err := c.GetLanguageSet()
+
+	assert.Nil(t, err)
+	assert.True(t, languages.IsValidSource("en"))
+	assert.False(t, languages.IsValidSource(LanguageEnglishAmerican))
+	assert.True(t, languages.IsValidTarget("en-us"))
+	assert.False(t, languages.IsValidTarget(LanguageEnglish))
+	assert.True(t, languages.SupportsFormality(LanguageGerman))
+	assert.False(t, languages.SupportsFormality(LanguageEnglishBritish))
+	assert.False(t, languages.SupportsFormality("XX"))
+	assert.Contains(t, languages.TargetCodes(), LanguagePortugueseBrazilian)
+
+	german, ok := languages.Target("de")
+	assert.True(t, ok)
+	assert.Equal(t, "German", german.Name)
+}
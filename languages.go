@@ -1,15 +1,15 @@
 package deeplgo
 
-import (
-	"fmt"
-)
+import "context"
 
-type Languages []struct {
-	Language          string `json:"language" validate:"required"`
-	Name              string `json:"name" validate:"required"`
-	SupportsFormality bool   `json:"supports_formality"`
+type Language struct {
+	Language          LanguageCode `json:"language" validate:"required"`
+	Name              string       `json:"name" validate:"required"`
+	SupportsFormality bool         `json:"supports_formality"`
 }
 
+type Languages []Language
+
 type LanguageType string
 
 const (
@@ -18,10 +18,14 @@ const (
 )
 
 func (c *Client) GetLanguages(target LanguageType) (*Languages, error) {
-	url := c.baseURL + fmt.Sprintf(languagesEndpoint, target)
+	return c.GetLanguagesContext(context.Background(), target)
+}
+
+func (c *Client) GetLanguagesContext(ctx context.Context, target LanguageType) (*Languages, error) {
+	url := c.endpointURL(languagesEndpoint, string(target))
 
 	res := Languages{}
-	if err := c.httpClient.Get(url, []QueryParameter{}, &res); err != nil {
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
 		return nil, err
 	}
 
@@ -32,6 +36,14 @@ func (c *Client) GetSourceLanguages() (*Languages, error) {
 	return c.GetLanguages(Source)
 }
 
+func (c *Client) GetSourceLanguagesContext(ctx context.Context) (*Languages, error) {
+	return c.GetLanguagesContext(ctx, Source)
+}
+
 func (c *Client) GetTargetLanguages() (*Languages, error) {
 	return c.GetLanguages(Target)
 }
+
+func (c *Client) GetTargetLanguagesContext(ctx context.Context) (*Languages, error) {
+	return c.GetLanguagesContext(ctx, Target)
+}
@@ -0,0 +1,31 @@
+package deeplgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client function GetLanguages for source and target languages
+// Function must send type of languages in `type` query parameter
+func Test_Client_GetLanguagesType(t *testing.T) {
+	types := []string{}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			types = append(types, r.URL.Query().Get("type"))
+			w.Write([]byte(`[{"language":"DE","name":"German","supports_formality":true}]`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+
+	_, err := c.GetSourceLanguages()
+	assert.Nil(t, err)
+	_, err = c.GetTargetLanguages()
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"source", "target"}, types)
+}
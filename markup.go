@@ -0,0 +1,42 @@
+package deeplgo
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkupError is returned when a text sent with tag handling is not
+// well-formed, Index is the position of the text in the request.
+type MarkupError struct {
+	Index int
+	Err   error
+}
+
+func (e *MarkupError) Error() string {
+	return fmt.Sprintf("text %d is not well-formed XML: %v", e.Index, e.Err)
+}
+
+func (e *MarkupError) Unwrap() error {
+	return e.Err
+}
+
+// checkWellFormedXML read every token of text and return first syntax error,
+// text can be a fragment with several root elements. HTML entities are
+// accepted as they are commonly declared by DTD (DITA, XHTML).
+func checkWellFormedXML(text string) error {
+	decoder := xml.NewDecoder(strings.NewReader(text))
+	decoder.Strict = true
+	decoder.Entity = xml.HTMLEntity
+
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package deeplgo
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// GlossaryDictionary describe the entries of one language pair of a
+// multilingual glossary.
+type GlossaryDictionary struct {
+	SourceLang string `json:"source_lang" validate:"required"`
+	TargetLang string `json:"target_lang" validate:"required"`
+	EntryCount int    `json:"entry_count"`
+}
+
+// MultilingualGlossary is a glossary of API v3, it hold one dictionary per
+// language pair.
+type MultilingualGlossary struct {
+	GlossaryID   GlossaryID           `json:"glossary_id" validate:"required"`
+	Name         string               `json:"name" validate:"required"`
+	Dictionaries []GlossaryDictionary `json:"dictionaries" validate:"dive"`
+	CreationTime time.Time            `json:"creation_time"`
+}
+
+type MultilingualGlossaries struct {
+	Glossaries []MultilingualGlossary `json:"glossaries" validate:"required,dive"`
+}
+
+// DictionaryEntries are entries of a multilingual glossary for one language
+// pair, each key of Entries in SourceLang is translated into its value in
+// TargetLang.
+type DictionaryEntries struct {
+	SourceLang string
+	TargetLang string
+	Entries    GlossaryEntries
+}
+
+type dictionaryRequest struct {
+	SourceLang    string `json:"source_lang" validate:"required"`
+	TargetLang    string `json:"target_lang" validate:"required"`
+	Entries       string `json:"entries"`
+	EntriesFormat string `json:"entries_format"`
+}
+
+type createMultilingualGlossaryRequest struct {
+	Name         string              `json:"name" validate:"required"`
+	Dictionaries []dictionaryRequest `json:"dictionaries" validate:"required,min=1,dive"`
+}
+
+type updateMultilingualGlossaryRequest struct {
+	Dictionaries []dictionaryRequest `json:"dictionaries" validate:"required,min=1,dive"`
+}
+
+type dictionaryEntriesResponse struct {
+	Dictionaries []dictionaryRequest `json:"dictionaries" validate:"required,len=1,dive"`
+}
+
+// newDictionaryRequest validate entries and language pair of dictionary and
+// encode them for a request.
+func (c *Client) newDictionaryRequest(ctx context.Context, dictionary DictionaryEntries) (dictionaryRequest, error) {
+	entries, err := dictionary.Entries.Encode(EntriesFormatTSV)
+	if err != nil {
+		return dictionaryRequest{}, err
+	}
+	if err := c.preflightGlossaryPair(ctx, dictionary.SourceLang, dictionary.TargetLang); err != nil {
+		return dictionaryRequest{}, err
+	}
+
+	return dictionaryRequest{
+		SourceLang:    dictionary.SourceLang,
+		TargetLang:    dictionary.TargetLang,
+		Entries:       entries,
+		EntriesFormat: string(EntriesFormatTSV),
+	}, nil
+}
+
+// CreateMultilingualGlossary create a glossary named name with a dictionary
+// for each language pair of dictionaries.
+func (c *Client) CreateMultilingualGlossary(name string, dictionaries []DictionaryEntries) (*MultilingualGlossary, error) {
+	return c.CreateMultilingualGlossaryContext(context.Background(), name, dictionaries)
+}
+
+func (c *Client) CreateMultilingualGlossaryContext(ctx context.Context, name string, dictionaries []DictionaryEntries) (*MultilingualGlossary, error) {
+	url := c.endpointURL(multilingualGlossariesEndpoint)
+
+	body := createMultilingualGlossaryRequest{Name: name}
+	for _, dictionary := range dictionaries {
+		dictionaryReq, err := c.newDictionaryRequest(ctx, dictionary)
+		if err != nil {
+			return nil, err
+		}
+		body.Dictionaries = append(body.Dictionaries, dictionaryReq)
+	}
+	if err := c.httpClient.validate.Struct(body); err != nil {
+		return nil, err
+	}
+
+	res := MultilingualGlossary{}
+	if err := c.httpClient.PostJSONContext(ctx, url, body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) ListMultilingualGlossaries() (*MultilingualGlossaries, error) {
+	return c.ListMultilingualGlossariesContext(context.Background())
+}
+
+func (c *Client) ListMultilingualGlossariesContext(ctx context.Context) (*MultilingualGlossaries, error) {
+	url := c.endpointURL(multilingualGlossariesEndpoint)
+
+	res := MultilingualGlossaries{}
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) GetMultilingualGlossary(glossaryID GlossaryID) (*MultilingualGlossary, error) {
+	return c.GetMultilingualGlossaryContext(context.Background(), glossaryID)
+}
+
+func (c *Client) GetMultilingualGlossaryContext(ctx context.Context, glossaryID GlossaryID) (*MultilingualGlossary, error) {
+	url := c.endpointURL(multilingualGlossaryEndpoint, string(glossaryID))
+
+	res := MultilingualGlossary{}
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (c *Client) DeleteMultilingualGlossary(glossaryID GlossaryID) error {
+	return c.DeleteMultilingualGlossaryContext(context.Background(), glossaryID)
+}
+
+func (c *Client) DeleteMultilingualGlossaryContext(ctx context.Context, glossaryID GlossaryID) error {
+	url := c.endpointURL(multilingualGlossaryEndpoint, string(glossaryID))
+
+	return c.httpClient.DeleteContext(ctx, url, nil)
+}
+
+// ListGlossaryDictionaries return language pairs of glossary with their
+// number of entries.
+func (c *Client) ListGlossaryDictionaries(glossaryID GlossaryID) ([]GlossaryDictionary, error) {
+	return c.ListGlossaryDictionariesContext(context.Background(), glossaryID)
+}
+
+func (c *Client) ListGlossaryDictionariesContext(ctx context.Context, glossaryID GlossaryID) ([]GlossaryDictionary, error) {
+	glossary, err := c.GetMultilingualGlossaryContext(ctx, glossaryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return glossary.Dictionaries, nil
+}
+
+// UpdateGlossaryDictionary merge entries of dictionary into the dictionary of
+// glossary with the same language pair, it is created if missing. Entries
+// already present are replaced.
+func (c *Client) UpdateGlossaryDictionary(glossaryID GlossaryID, dictionary DictionaryEntries) (*MultilingualGlossary, error) {
+	return c.UpdateGlossaryDictionaryContext(context.Background(), glossaryID, dictionary)
+}
+
+func (c *Client) UpdateGlossaryDictionaryContext(ctx context.Context, glossaryID GlossaryID, dictionary DictionaryEntries) (*MultilingualGlossary, error) {
+	url := c.endpointURL(multilingualGlossaryEndpoint, string(glossaryID))
+
+	dictionaryReq, err := c.newDictionaryRequest(ctx, dictionary)
+	if err != nil {
+		return nil, err
+	}
+	body := updateMultilingualGlossaryRequest{Dictionaries: []dictionaryRequest{dictionaryReq}}
+	if err := c.httpClient.validate.Struct(body); err != nil {
+		return nil, err
+	}
+
+	res := MultilingualGlossary{}
+	if err := c.httpClient.PatchJSONContext(ctx, url, body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ReplaceGlossaryDictionary replace all entries of the dictionary of glossary
+// with the same language pair by entries of dictionary.
+func (c *Client) ReplaceGlossaryDictionary(glossaryID GlossaryID, dictionary DictionaryEntries) (*GlossaryDictionary, error) {
+	return c.ReplaceGlossaryDictionaryContext(context.Background(), glossaryID, dictionary)
+}
+
+func (c *Client) ReplaceGlossaryDictionaryContext(ctx context.Context, glossaryID GlossaryID, dictionary DictionaryEntries) (*GlossaryDictionary, error) {
+	url := c.endpointURL(glossaryDictionariesEndpoint, string(glossaryID))
+
+	body, err := c.newDictionaryRequest(ctx, dictionary)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.httpClient.validate.Struct(body); err != nil {
+		return nil, err
+	}
+
+	res := GlossaryDictionary{}
+	if err := c.httpClient.PutJSONContext(ctx, url, body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// DeleteGlossaryDictionary delete dictionary of glossary from sourceLang to
+// targetLang.
+func (c *Client) DeleteGlossaryDictionary(glossaryID GlossaryID, sourceLang string, targetLang string) error {
+	return c.DeleteGlossaryDictionaryContext(context.Background(), glossaryID, sourceLang, targetLang)
+}
+
+func (c *Client) DeleteGlossaryDictionaryContext(ctx context.Context, glossaryID GlossaryID, sourceLang string, targetLang string) error {
+	url := c.endpointURL(glossaryDictionariesEndpoint, string(glossaryID)) + "?" + languagePairQuery(sourceLang, targetLang)
+
+	return c.httpClient.DeleteContext(ctx, url, nil)
+}
+
+// GetGlossaryDictionaryEntries return entries of glossary from sourceLang to
+// targetLang.
+func (c *Client) GetGlossaryDictionaryEntries(glossaryID GlossaryID, sourceLang string, targetLang string) (GlossaryEntries, error) {
+	return c.GetGlossaryDictionaryEntriesContext(context.Background(), glossaryID, sourceLang, targetLang)
+}
+
+func (c *Client) GetGlossaryDictionaryEntriesContext(ctx context.Context, glossaryID GlossaryID, sourceLang string, targetLang string) (GlossaryEntries, error) {
+	url := c.endpointURL(multilingualGlossaryEntriesEndpoint, string(glossaryID)) + "?" + languagePairQuery(sourceLang, targetLang)
+
+	res := dictionaryEntriesResponse{}
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
+		return nil, err
+	}
+	format := EntriesFormat(res.Dictionaries[0].EntriesFormat)
+	if format == "" {
+		format = EntriesFormatTSV
+	}
+
+	return ParseGlossaryEntries(res.Dictionaries[0].Entries, format)
+}
+
+func languagePairQuery(sourceLang string, targetLang string) string {
+	return url.Values{
+		"source_lang": {sourceLang},
+		"target_lang": {targetLang},
+	}.Encode()
+}
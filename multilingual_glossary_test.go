@@ -0,0 +1,112 @@
+package deeplgo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client function CreateMultilingualGlossary with two dictionaries
+// Function must send a dictionary per language pair and decode glossary
+func Test_Client_CreateMultilingualGlossary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.Method, http.MethodPost)
+			assert.Equal(t, r.URL.Path, multilingualGlossariesEndpoint)
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"name":"My glossary",
+				"dictionaries":[
+					{"source_lang":"en","target_lang":"de","entries":"Apple\tApfel\n","entries_format":"tsv"},
+					{"source_lang":"en","target_lang":"fr","entries":"Apple\tPomme\n","entries_format":"tsv"}
+				]
+			}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{
+				"glossary_id":"def3a26b-3e84-45b3-84ae-0c0aaf3525f7",
+				"name":"My glossary",
+				"dictionaries":[
+					{"source_lang":"en","target_lang":"de","entry_count":1},
+					{"source_lang":"en","target_lang":"fr","entry_count":1}
+				],
+				"creation_time":"2024-08-03T14:16:18.329Z"
+			}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.CreateMultilingualGlossary("My glossary", []DictionaryEntries{
+		{SourceLang: "en", TargetLang: "de", Entries: map[string]string{"Apple": "Apfel"}},
+		{SourceLang: "en", TargetLang: "fr", Entries: map[string]string{"Apple": "Pomme"}},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, GlossaryID("def3a26b-3e84-45b3-84ae-0c0aaf3525f7"), res.GlossaryID)
+	assert.Equal(t, []GlossaryDictionary{
+		{SourceLang: "en", TargetLang: "de", EntryCount: 1},
+		{SourceLang: "en", TargetLang: "fr", EntryCount: 1},
+	}, res.Dictionaries)
+}
+
+// Test Client functions UpdateGlossaryDictionary and ReplaceGlossaryDictionary
+// Update must PATCH glossary and replace must PUT its dictionaries
+func Test_Client_UpdateAndReplaceGlossaryDictionary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			switch r.Method {
+			case http.MethodPatch:
+				assert.Equal(t, r.URL.Path, "/v3/glossaries/ID")
+				assert.JSONEq(t, `{"dictionaries":[{"source_lang":"en","target_lang":"de","entries":"Pear\tBirne\n","entries_format":"tsv"}]}`, string(body))
+				w.Write([]byte(`{"glossary_id":"ID","name":"My glossary","dictionaries":[{"source_lang":"en","target_lang":"de","entry_count":2}]}`))
+			case http.MethodPut:
+				assert.Equal(t, r.URL.Path, "/v3/glossaries/ID/dictionaries")
+				assert.JSONEq(t, `{"source_lang":"en","target_lang":"de","entries":"Pear\tBirne\n","entries_format":"tsv"}`, string(body))
+				w.Write([]byte(`{"source_lang":"en","target_lang":"de","entry_count":1}`))
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+	dictionary := DictionaryEntries{SourceLang: "en", TargetLang: "de", Entries: map[string]string{"Pear": "Birne"}}
+
+	glossary, err := c.UpdateGlossaryDictionary("ID", dictionary)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, glossary.Dictionaries[0].EntryCount)
+
+	replaced, err := c.ReplaceGlossaryDictionary("ID", dictionary)
+	assert.Nil(t, err)
+	assert.Equal(t, &GlossaryDictionary{SourceLang: "en", TargetLang: "de", EntryCount: 1}, replaced)
+}
+
+// Test Client function GetGlossaryDictionaryEntries
+// Function must ask language pair in query and decode TSV entries
+func Test_Client_GetGlossaryDictionaryEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, "/v3/glossaries/ID/entries")
+			assert.Equal(t, r.URL.Query().Get("source_lang"), "en")
+			assert.Equal(t, r.URL.Query().Get("target_lang"), "de")
+			w.Write([]byte(`{"dictionaries":[{"source_lang":"en","target_lang":"de","entries":"Apple\tApfel\nPear\tBirne","entries_format":"tsv"}]}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.GetGlossaryDictionaryEntries("ID", "en", "de")
+
+	assert.Nil(t, err)
+	assert.Equal(t, GlossaryEntries{"Apple": "Apfel", "Pear": "Birne"}, res)
+}
@@ -0,0 +1,119 @@
+package deeplgo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LanguageError is returned by pre-flight validation for a language DeepL
+// doesn't support, Valid list supported languages for this parameter.
+type LanguageError struct {
+	Param string
+	Code  LanguageCode
+	Valid []LanguageCode
+}
+
+func (e *LanguageError) Error() string {
+	return fmt.Sprintf("unsupported %s %q, valid languages are %s", e.Param, e.Code, joinLanguageCodes(e.Valid))
+}
+
+// FormalityError is returned by pre-flight validation for a formality which
+// target language doesn't support, Valid list target languages supporting
+// it. Formalities prefer_more and prefer_less are never rejected as DeepL
+// ignore them for such languages.
+type FormalityError struct {
+	TargetLang LanguageCode
+	Formality  Formality
+	Valid      []LanguageCode
+}
+
+func (e *FormalityError) Error() string {
+	return fmt.Sprintf("target language %q doesn't support formality %q, languages supporting it are %s", e.TargetLang, e.Formality, joinLanguageCodes(e.Valid))
+}
+
+// GlossaryPairError is returned by pre-flight validation for a language pair
+// glossaries don't support, Valid list supported pairs.
+type GlossaryPairError struct {
+	Pair  GlossaryLanguagePair
+	Valid []GlossaryLanguagePair
+}
+
+func (e *GlossaryPairError) Error() string {
+	valid := make([]string, len(e.Valid))
+	for i, pair := range e.Valid {
+		valid[i] = pair.SourceLang + "->" + pair.TargetLang
+	}
+
+	return fmt.Sprintf("unsupported glossary language pair %s->%s, valid pairs are %s", e.Pair.SourceLang, e.Pair.TargetLang, strings.Join(valid, ", "))
+}
+
+func joinLanguageCodes(codes []LanguageCode) string {
+	valid := make([]string, len(codes))
+	for i, code := range codes {
+		valid[i] = string(code)
+	}
+
+	return strings.Join(valid, ", ")
+}
+
+// preflightTranslate check languages, formality and glossary language pair of
+// a translation against catalog of client, when pre-flight validation is
+// enabled.
+func (c *Client) preflightTranslate(ctx context.Context, sourceLang string, targetLang string, formality Formality, glossaryID GlossaryID) error {
+	if c.catalog == nil {
+		return nil
+	}
+
+	languages, err := c.catalog.LanguagesContext(ctx)
+	if err != nil {
+		return err
+	}
+	// Codes are checked as DeepL read them, a target EN is EN-US
+	if sourceLang != "" && !languages.IsValidSource(LanguageCode(sourceLang).AsSource()) {
+		return &LanguageError{Param: "source_lang", Code: LanguageCode(sourceLang), Valid: languages.SourceCodes()}
+	}
+	if !languages.IsValidTarget(LanguageCode(targetLang).AsTarget()) {
+		return &LanguageError{Param: "target_lang", Code: LanguageCode(targetLang), Valid: languages.TargetCodes()}
+	}
+	if (formality == FormalityMore || formality == FormalityLess) && !languages.SupportsFormality(LanguageCode(targetLang).AsTarget()) {
+		valid := []LanguageCode{}
+		for _, code := range languages.TargetCodes() {
+			if languages.SupportsFormality(code) {
+				valid = append(valid, code)
+			}
+		}
+		return &FormalityError{TargetLang: LanguageCode(targetLang), Formality: formality, Valid: valid}
+	}
+
+	if glossaryID != "" && sourceLang != "" {
+		return c.preflightGlossaryPair(ctx, sourceLang, targetLang)
+	}
+
+	return nil
+}
+
+// preflightGlossaryPair check glossaries support language pair, when
+// pre-flight validation is enabled. Regional variants are ignored as a
+// glossary for EN apply to EN-GB and EN-US.
+func (c *Client) preflightGlossaryPair(ctx context.Context, sourceLang string, targetLang string) error {
+	if c.catalog == nil {
+		return nil
+	}
+
+	pairs, err := c.catalog.GlossaryLanguagePairsContext(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs.SupportedLanguages {
+		if LanguageCode(pair.SourceLang).Base() == LanguageCode(sourceLang).Base() &&
+			LanguageCode(pair.TargetLang).Base() == LanguageCode(targetLang).Base() {
+			return nil
+		}
+	}
+
+	return &GlossaryPairError{
+		Pair:  GlossaryLanguagePair{SourceLang: sourceLang, TargetLang: targetLang},
+		Valid: pairs.SupportedLanguages,
+	}
+}
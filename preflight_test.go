@@ -0,0 +1,92 @@
+package deeplgo
+
+import (
+	"testing"
+
+	"github.com/ThibaudDemay/deepl-go/deepltest"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client with pre-flight validation on unsupported languages
+// Request must not be sent and error must list valid languages
+func Test_Client_PreflightLanguages(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()), WithPreflightValidation())
+
+	_, err := c.TranslateText([]string{"Hello"}, "XX")
+	languageErr := &LanguageError{}
+	if assert.ErrorAs(t, err, &languageErr) {
+		assert.Equal(t, "target_lang", languageErr.Param)
+		assert.Contains(t, languageErr.Valid, LanguageEnglishBritish)
+	}
+
+	_, err = c.TranslateBatch([]string{"Hello"}, "DE", WithSourceLang("XX"))
+	if assert.ErrorAs(t, err, &languageErr) {
+		assert.Equal(t, "source_lang", languageErr.Param)
+		assert.Contains(t, err.Error(), "valid languages are DE, EN, ES")
+	}
+
+	_, err = c.TranslateText([]string{"Hello"}, "de", WithSourceLang("en"))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, server.CharacterCount())
+
+	// Codes are checked as DeepL read them, legacy targets without variant
+	// are still accepted
+	for _, targetLang := range []string{"EN", "PT", "en_us"} {
+		_, err = c.TranslateText([]string{"Hello"}, targetLang, WithSourceLang("DE"))
+		assert.Nil(t, err, targetLang)
+	}
+	_, err = c.TranslateText([]string{"Hello"}, "DE", WithSourceLang("en-gb"))
+	assert.Nil(t, err)
+}
+
+// Test Client with pre-flight validation on formality
+// Formality must be rejected only when target language can't apply it
+func Test_Client_PreflightFormality(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()), WithPreflightValidation())
+
+	_, err := c.TranslateText([]string{"Hello"}, "EN-GB", WithFormality(FormalityMore))
+	formalityErr := &FormalityError{}
+	if assert.ErrorAs(t, err, &formalityErr) {
+		assert.Equal(t, LanguageEnglishBritish, formalityErr.TargetLang)
+		assert.Contains(t, formalityErr.Valid, LanguageGerman)
+		assert.NotContains(t, formalityErr.Valid, LanguageEnglishAmerican)
+	}
+
+	_, err = c.TranslateText([]string{"Hello"}, "EN-GB", WithFormality(FormalityPreferMore))
+	assert.Nil(t, err)
+
+	_, err = c.TranslateText([]string{"Hello"}, "DE", WithFormality(FormalityLess))
+	assert.Nil(t, err)
+}
+
+// Test Client with pre-flight validation on glossary language pairs
+// Glossary creation and use must be rejected for an unsupported pair
+func Test_Client_PreflightGlossaryPair(t *testing.T) {
+	server := deepltest.NewServer()
+	defer server.Close()
+	c := NewClient(server.AuthKey(), WithServerURL(server.URL()), WithPreflightValidation())
+
+	_, err := c.CreateGlossary("test", "de", "ja", GlossaryEntries{"Apfel": "りんご"})
+	pairErr := &GlossaryPairError{}
+	if assert.ErrorAs(t, err, &pairErr) {
+		assert.Contains(t, pairErr.Valid, GlossaryLanguagePair{SourceLang: "en", TargetLang: "ja"})
+	}
+
+	_, err = c.CreateMultilingualGlossary("test", []DictionaryEntries{
+		{SourceLang: "en", TargetLang: "de", Entries: GlossaryEntries{"Apple": "Apfel"}},
+		{SourceLang: "de", TargetLang: "ja", Entries: GlossaryEntries{"Apfel": "りんご"}},
+	})
+	assert.ErrorAs(t, err, &pairErr)
+
+	_, err = c.TranslateText([]string{"Apfel"}, "JA", WithSourceLang("DE"), WithGlossaryID("ID"))
+	assert.ErrorAs(t, err, &pairErr)
+
+	glossary, err := c.CreateGlossary("test", "en", "de", GlossaryEntries{"Apple": "Apfel"})
+	assert.Nil(t, err)
+	_, err = c.TranslateText([]string{"Apple"}, "DE", WithSourceLang("EN"), WithGlossaryID(glossary.GlossaryID))
+	assert.Nil(t, err)
+}
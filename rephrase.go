@@ -0,0 +1,98 @@
+package deeplgo
+
+import "context"
+
+type WritingStyle string
+
+const (
+	WritingStyleDefault        WritingStyle = "default"
+	WritingStyleSimple         WritingStyle = "simple"
+	WritingStyleBusiness       WritingStyle = "business"
+	WritingStyleAcademic       WritingStyle = "academic"
+	WritingStyleCasual         WritingStyle = "casual"
+	WritingStylePreferSimple   WritingStyle = "prefer_simple"
+	WritingStylePreferBusiness WritingStyle = "prefer_business"
+	WritingStylePreferAcademic WritingStyle = "prefer_academic"
+	WritingStylePreferCasual   WritingStyle = "prefer_casual"
+)
+
+type Tone string
+
+const (
+	ToneDefault            Tone = "default"
+	ToneEnthusiastic       Tone = "enthusiastic"
+	ToneFriendly           Tone = "friendly"
+	ToneConfident          Tone = "confident"
+	ToneDiplomatic         Tone = "diplomatic"
+	TonePreferEnthusiastic Tone = "prefer_enthusiastic"
+	TonePreferFriendly     Tone = "prefer_friendly"
+	TonePreferConfident    Tone = "prefer_confident"
+	TonePreferDiplomatic   Tone = "prefer_diplomatic"
+)
+
+type Improvement struct {
+	Text                   string `json:"text"`
+	TargetLanguage         string `json:"target_language"`
+	DetectedSourceLanguage string `json:"detected_source_language" validate:"required"`
+}
+
+type Improvements struct {
+	Improvements []Improvement `json:"improvements" validate:"required,dive"`
+}
+
+// RephraseOptions hold optional parameters of a rephrase request, only one of
+// writing style and tone can be set.
+type RephraseOptions struct {
+	WritingStyle WritingStyle `json:"writing_style,omitempty" validate:"omitempty,excluded_with=Tone,oneof=default simple business academic casual prefer_simple prefer_business prefer_academic prefer_casual"`
+	Tone         Tone         `json:"tone,omitempty" validate:"omitempty,oneof=default enthusiastic friendly confident diplomatic prefer_enthusiastic prefer_friendly prefer_confident prefer_diplomatic"`
+}
+
+// RephraseOption set an optional parameter of a rephrase request
+type RephraseOption func(*RephraseOptions)
+
+func WithWritingStyle(writingStyle WritingStyle) RephraseOption {
+	return func(o *RephraseOptions) {
+		o.WritingStyle = writingStyle
+	}
+}
+
+func WithTone(tone Tone) RephraseOption {
+	return func(o *RephraseOptions) {
+		o.Tone = tone
+	}
+}
+
+type rephraseRequest struct {
+	Text       []string `json:"text" validate:"required,min=1,max=50"`
+	TargetLang string   `json:"target_lang,omitempty"`
+	RephraseOptions
+}
+
+// RephraseText improve each text of texts with DeepL Write, improvements are
+// returned in the same order as texts. Text is rephrased in targetLang, or in
+// its detected language when targetLang is empty.
+func (c *Client) RephraseText(texts []string, targetLang string, opts ...RephraseOption) (*Improvements, error) {
+	return c.RephraseTextContext(context.Background(), texts, targetLang, opts...)
+}
+
+func (c *Client) RephraseTextContext(ctx context.Context, texts []string, targetLang string, opts ...RephraseOption) (*Improvements, error) {
+	url := c.endpointURL(rephraseEndpoint)
+
+	body := rephraseRequest{
+		Text:       texts,
+		TargetLang: targetLang,
+	}
+	for _, opt := range opts {
+		opt(&body.RephraseOptions)
+	}
+	if err := c.httpClient.validate.Struct(body); err != nil {
+		return nil, err
+	}
+
+	res := Improvements{}
+	if err := c.httpClient.PostJSONContext(ctx, url, body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,78 @@
+package deeplgo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client function RephraseText with writing style
+// Function must send options in request body and decode improvements
+func Test_Client_RephraseText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, rephraseEndpoint)
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"text":["i has a apple"],
+				"target_lang":"en-GB",
+				"writing_style":"business"
+			}`, string(body))
+			w.Write([]byte(`{"improvements":[{"text":"I have an apple.","target_language":"en-GB","detected_source_language":"en"}]}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.RephraseText([]string{"i has a apple"}, "en-GB", WithWritingStyle(WritingStyleBusiness))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &Improvements{Improvements: []Improvement{
+		{Text: "I have an apple.", TargetLanguage: "en-GB", DetectedSourceLanguage: "en"},
+	}}, res)
+}
+
+// Test Client function RephraseText with writing style and tone
+// Function must return a validation error without sending request
+func Test_Client_RephraseTextStyleAndTone(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	_, err := c.RephraseText([]string{"Hello"}, "", WithWritingStyle(WritingStyleCasual), WithTone(ToneFriendly))
+
+	assert.IsType(t, validator.ValidationErrors{}, err)
+	assert.Equal(t, 0, requests)
+}
+
+// Test Client function RephraseText with quota exceeded
+// Function must return APIError like other endpoints
+func Test_Client_RephraseTextError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(456)
+			w.Write([]byte(`{"message":"Quota exceeded"}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithRetryPolicy(NoRetryPolicy()))
+	c.SetBaseUrl(server.URL)
+
+	_, err := c.RephraseText([]string{"Hello"}, "")
+
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+}
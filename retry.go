@@ -0,0 +1,99 @@
+package deeplgo
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy define how HTTPClient resend a request which failed with a
+// transient error. Delay between attempts grows exponentially from BaseDelay
+// up to MaxDelay, unless response ask for another delay with `Retry-After`.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one, retry
+	// is disabled when lower than 2
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// Jitter is the fraction of delay randomly removed, between 0 and 1
+	Jitter float64
+	// StatusCodes are HTTP status codes of responses to retry
+	StatusCodes []int
+	// RetryNetworkErrors retry requests failing with a temporary
+	// TransportError. POST and PATCH requests are retried only when
+	// connection to server failed, unless RetryUnsafeRequests is set.
+	RetryNetworkErrors bool
+	// RetryUnsafeRequests retry POST and PATCH requests also after a timeout
+	// or a connection lost, when request may have reached DeepL which could
+	// translate and bill it twice
+	RetryUnsafeRequests bool
+}
+
+// DefaultRetryPolicy retry on too many requests, internal error and resource
+// unavailable up to 5 attempts.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:        5,
+		BaseDelay:          500 * time.Millisecond,
+		MaxDelay:           30 * time.Second,
+		Jitter:             0.2,
+		StatusCodes:        []int{429, 529, 500, 503},
+		RetryNetworkErrors: true,
+	}
+}
+
+// NoRetryPolicy send each request only once
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// shouldRetry tell if a request with method which failed with err at attempt
+// (starting at 1) can be sent again.
+func (p *RetryPolicy) shouldRetry(attempt int, method string, err error) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	var (
+		apiErr       *APIError
+		transportErr *TransportError
+	)
+	if errors.As(err, &apiErr) {
+		for _, statusCode := range p.StatusCodes {
+			if apiErr.StatusCode == statusCode {
+				return true
+			}
+		}
+	} else if errors.As(err, &transportErr) {
+		if !p.RetryNetworkErrors || !transportErr.Temporary() {
+			return false
+		}
+		unsafe := method == http.MethodPost || method == http.MethodPatch
+		return !unsafe || p.RetryUnsafeRequests || transportErr.notSent()
+	}
+
+	return false
+}
+
+// delay return time to wait before attempt+1
+func (p *RetryPolicy) delay(attempt int, err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			delay = p.MaxDelay
+			break
+		}
+	}
+	if p.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
@@ -0,0 +1,185 @@
+package deeplgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	return policy
+}
+
+// Test HTTPClient function PostJSON on a server unavailable twice
+// Function must resend same body until success
+func Test_HTTPClient_RetryPostBody(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"text":"Hello"}`, string(body))
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"page":1,"count":1,"data":["Apple"]}`))
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+	hc.SetRetryPolicy(testRetryPolicy())
+
+	res := TestStruct{}
+	err := hc.PostJSON(server.URL, map[string]string{"text": "Hello"}, &res)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+// Test HTTPClient function Get on a server always returning too many requests
+// Function must stop after max attempts and return last error
+func Test_HTTPClient_RetryMaxAttempts(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+	hc.SetRetryPolicy(policy)
+
+	err := hc.Get(server.URL, []QueryParameter{}, &TestStruct{})
+
+	assert.ErrorIs(t, err, ErrTooManyRequests)
+	assert.Equal(t, 3, calls)
+}
+
+// Test HTTPClient function Get on error not in retry policy
+// Function must not resend request
+func Test_HTTPClient_RetryNotRetryable(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(456)
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+	hc.SetRetryPolicy(testRetryPolicy())
+
+	err := hc.Get(server.URL, []QueryParameter{}, &TestStruct{})
+
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.Equal(t, 1, calls)
+}
+
+// Test HTTPClient function GetContext canceled while waiting before retry
+// Function must return error of context without waiting delay
+func Test_HTTPClient_RetryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			cancel()
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+	policy := testRetryPolicy()
+	policy.BaseDelay = time.Minute
+	hc.SetRetryPolicy(policy)
+
+	start := time.Now()
+	err := hc.GetContext(ctx, server.URL, []QueryParameter{}, &TestStruct{})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+// Test HTTPClient functions PostJSON and Get on a server slower than client
+// timeout
+// POST must not be resent as server may have processed it, unless policy
+// allow it, GET must be resent
+func Test_HTTPClient_RetryTimeout(t *testing.T) {
+	calls := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(50 * time.Millisecond)
+		},
+	))
+
+	defer server.Close()
+	hc := NewHTTPClient("NO_API_KEY")
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 2
+	hc.SetRetryPolicy(policy)
+	hc.client.Timeout = 10 * time.Millisecond
+
+	err := hc.PostJSON(server.URL, map[string]string{"text": "Hello"}, &TestStruct{})
+	assert.ErrorAs(t, err, new(*TransportError))
+	assert.Equal(t, int32(1), atomic.SwapInt32(&calls, 0))
+
+	err = hc.Get(server.URL, []QueryParameter{}, &TestStruct{})
+	assert.ErrorAs(t, err, new(*TransportError))
+	assert.Equal(t, int32(2), atomic.SwapInt32(&calls, 0))
+
+	policy.RetryUnsafeRequests = true
+	hc.SetRetryPolicy(policy)
+	err = hc.PostJSON(server.URL, map[string]string{"text": "Hello"}, &TestStruct{})
+	assert.ErrorAs(t, err, new(*TransportError))
+	assert.Equal(t, int32(2), atomic.SwapInt32(&calls, 0))
+}
+
+// Test RetryPolicy function shouldRetry on transport errors
+// POST must be retried only when connection to server failed
+func Test_RetryPolicy_ShouldRetryTransportError(t *testing.T) {
+	policy := testRetryPolicy()
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("network is unreachable")}
+	readErr := &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}
+
+	assert.True(t, policy.shouldRetry(1, http.MethodPost, newTransportError(dialErr)))
+	assert.True(t, policy.shouldRetry(1, http.MethodPost, &TransportError{Kind: TransportErrorConnectionRefused, Err: syscall.ECONNREFUSED}))
+	assert.True(t, policy.shouldRetry(1, http.MethodPost, &TransportError{Kind: TransportErrorDNS, Err: &net.DNSError{}}))
+	assert.False(t, policy.shouldRetry(1, http.MethodPost, newTransportError(readErr)))
+	assert.False(t, policy.shouldRetry(1, http.MethodPatch, newTransportError(readErr)))
+	assert.True(t, policy.shouldRetry(1, http.MethodGet, newTransportError(readErr)))
+	assert.True(t, policy.shouldRetry(1, http.MethodDelete, newTransportError(readErr)))
+}
+
+// Test RetryPolicy function delay
+// Function must grow exponentially up to max delay and honor Retry-After
+func Test_RetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	err := &APIError{StatusCode: http.StatusServiceUnavailable}
+
+	assert.Equal(t, time.Second, policy.delay(1, err))
+	assert.Equal(t, 2*time.Second, policy.delay(2, err))
+	assert.Equal(t, 4*time.Second, policy.delay(3, err))
+	assert.Equal(t, 5*time.Second, policy.delay(4, err))
+
+	err.RetryAfter = 42 * time.Second
+	assert.Equal(t, 42*time.Second, policy.delay(1, err))
+}
@@ -0,0 +1,246 @@
+package deeplgo
+
+import "context"
+
+type Formality string
+
+const (
+	FormalityDefault    Formality = "default"
+	FormalityMore       Formality = "more"
+	FormalityLess       Formality = "less"
+	FormalityPreferMore Formality = "prefer_more"
+	FormalityPreferLess Formality = "prefer_less"
+)
+
+type SplitSentences string
+
+const (
+	SplitSentencesOff        SplitSentences = "0"
+	SplitSentencesOn         SplitSentences = "1"
+	SplitSentencesNoNewlines SplitSentences = "nonewlines"
+)
+
+type ModelType string
+
+const (
+	ModelTypeQualityOptimized       ModelType = "quality_optimized"
+	ModelTypePreferQualityOptimized ModelType = "prefer_quality_optimized"
+	ModelTypeLatencyOptimized       ModelType = "latency_optimized"
+)
+
+type TagHandling string
+
+const (
+	TagHandlingXML  TagHandling = "xml"
+	TagHandlingHTML TagHandling = "html"
+)
+
+type Translation struct {
+	DetectedSourceLanguage string    `json:"detected_source_language" validate:"required"`
+	Text                   string    `json:"text"`
+	BilledCharacters       int       `json:"billed_characters,omitempty"`
+	ModelTypeUsed          ModelType `json:"model_type_used,omitempty"`
+}
+
+type Translations struct {
+	Translations []Translation `json:"translations" validate:"required,dive"`
+}
+
+// TranslateOptions hold every optional parameter of a translation request,
+// they are checked before sending request so an invalid value is never billed.
+type TranslateOptions struct {
+	SourceLang           string         `json:"source_lang,omitempty" validate:"required_with=GlossaryID"`
+	Formality            Formality      `json:"formality,omitempty" validate:"omitempty,oneof=default more less prefer_more prefer_less"`
+	SplitSentences       SplitSentences `json:"split_sentences,omitempty" validate:"omitempty,oneof=0 1 nonewlines"`
+	PreserveFormatting   bool           `json:"preserve_formatting,omitempty"`
+	GlossaryID           GlossaryID     `json:"glossary_id,omitempty"`
+	Context              string         `json:"context,omitempty"`
+	ModelType            ModelType      `json:"model_type,omitempty" validate:"omitempty,oneof=quality_optimized prefer_quality_optimized latency_optimized"`
+	ShowBilledCharacters bool           `json:"show_billed_characters,omitempty"`
+	TagHandling          TagHandling    `json:"tag_handling,omitempty" validate:"omitempty,oneof=xml html"`
+	OutlineDetection     *bool          `json:"outline_detection,omitempty"`
+	NonSplittingTags     []string       `json:"non_splitting_tags,omitempty" validate:"omitempty,dive,required"`
+	SplittingTags        []string       `json:"splitting_tags,omitempty" validate:"omitempty,dive,required"`
+	IgnoreTags           []string       `json:"ignore_tags,omitempty" validate:"omitempty,dive,required"`
+}
+
+// TranslateOption set an optional parameter of a translation request
+type TranslateOption func(*TranslateOptions)
+
+// WithOptions replace all optional parameters by options
+func WithOptions(options TranslateOptions) TranslateOption {
+	return func(o *TranslateOptions) {
+		*o = options
+	}
+}
+
+func WithSourceLang(sourceLang string) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.SourceLang = sourceLang
+	}
+}
+
+func WithFormality(formality Formality) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.Formality = formality
+	}
+}
+
+func WithSplitSentences(splitSentences SplitSentences) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.SplitSentences = splitSentences
+	}
+}
+
+func WithPreserveFormatting(preserveFormatting bool) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.PreserveFormatting = preserveFormatting
+	}
+}
+
+// WithGlossaryID use glossary for translation, source language must be set
+// with WithSourceLang too.
+func WithGlossaryID(glossaryID GlossaryID) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.GlossaryID = glossaryID
+	}
+}
+
+// WithTranslationContext give additional text which influence translation
+// without being translated itself.
+func WithTranslationContext(context string) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.Context = context
+	}
+}
+
+func WithModelType(modelType ModelType) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.ModelType = modelType
+	}
+}
+
+func WithShowBilledCharacters(showBilledCharacters bool) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.ShowBilledCharacters = showBilledCharacters
+	}
+}
+
+// WithTagHandling enable tag handling, with TagHandlingXML texts are checked to
+// be well-formed before sending request.
+func WithTagHandling(tagHandling TagHandling) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.TagHandling = tagHandling
+	}
+}
+
+// WithOutlineDetection can disable automatic detection of XML structure, it is
+// enabled by DeepL when not set.
+func WithOutlineDetection(outlineDetection bool) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.OutlineDetection = &outlineDetection
+	}
+}
+
+func WithNonSplittingTags(tags ...string) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.NonSplittingTags = tags
+	}
+}
+
+func WithSplittingTags(tags ...string) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.SplittingTags = tags
+	}
+}
+
+func WithIgnoreTags(tags ...string) TranslateOption {
+	return func(o *TranslateOptions) {
+		o.IgnoreTags = tags
+	}
+}
+
+type translateRequest struct {
+	Text       []string `json:"text" validate:"required,min=1,max=50"`
+	TargetLang string   `json:"target_lang" validate:"required"`
+	TranslateOptions
+}
+
+// TranslateText translate each text of texts into targetLang, translations are
+// returned in the same order as texts. More texts or bytes than DeepL accept
+// in a request are sent with TranslateBatch, so texts are packed in several
+// requests and texts too large are split and joined back, and error of the
+// first text which failed is returned.
+func (c *Client) TranslateText(texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	return c.TranslateTextContext(context.Background(), texts, targetLang, opts...)
+}
+
+func (c *Client) TranslateTextContext(ctx context.Context, texts []string, targetLang string, opts ...TranslateOption) (*Translations, error) {
+	url := c.endpointURL(translateEndpoint)
+
+	body := translateRequest{
+		Text:       texts,
+		TargetLang: targetLang,
+	}
+	for _, opt := range opts {
+		opt(&body.TranslateOptions)
+	}
+	if len(texts) > maxTextsPerRequest {
+		return c.translateLarge(ctx, texts, targetLang, body.TranslateOptions)
+	}
+	if err := c.checkTranslateRequest(&body); err != nil {
+		return nil, err
+	}
+	if encoded, err := encodeJSON(body); err != nil {
+		return nil, err
+	} else if len(encoded) > maxRequestSize {
+		return c.translateLarge(ctx, texts, targetLang, body.TranslateOptions)
+	}
+	if err := c.preflightTranslate(ctx, body.SourceLang, targetLang, body.Formality, body.GlossaryID); err != nil {
+		return nil, err
+	}
+
+	res := Translations{}
+	if err := c.httpClient.PostJSONContext(ctx, url, body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// translateLarge translate texts exceeding request count or size with
+// TranslateBatch
+func (c *Client) translateLarge(ctx context.Context, texts []string, targetLang string, options TranslateOptions) (*Translations, error) {
+	results, err := c.TranslateBatchContext(ctx, texts, targetLang, WithOptions(options))
+	if err != nil {
+		return nil, err
+	}
+
+	res := Translations{Translations: make([]Translation, len(results))}
+	for i, result := range results {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		res.Translations[i] = result.Translation
+	}
+
+	return &res, nil
+}
+
+// checkTranslateRequest validate request parameters and, when tag handling is
+// XML, that every text is well-formed.
+func (c *Client) checkTranslateRequest(body *translateRequest) error {
+	if err := c.httpClient.validate.Struct(body); err != nil {
+		return err
+	}
+
+	if body.TagHandling == TagHandlingXML {
+		for i, text := range body.Text {
+			if err := checkWellFormedXML(text); err != nil {
+				return &MarkupError{Index: i, Err: err}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package deeplgo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test Client function TranslateText with options
+// Function must send options in request body and decode translations
+func Test_Client_TranslateTextOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, r.URL.Path, translateEndpoint)
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"text":["Hello"],
+				"target_lang":"DE",
+				"source_lang":"EN",
+				"formality":"prefer_less",
+				"split_sentences":"nonewlines",
+				"preserve_formatting":true,
+				"context":"Greeting",
+				"model_type":"quality_optimized",
+				"show_billed_characters":true
+			}`, string(body))
+			w.Write([]byte(`{"translations":[{"detected_source_language":"EN","text":"Hallo","billed_characters":5}]}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.TranslateText([]string{"Hello"}, "DE",
+		WithSourceLang("EN"),
+		WithFormality(FormalityPreferLess),
+		WithSplitSentences(SplitSentencesNoNewlines),
+		WithPreserveFormatting(true),
+		WithTranslationContext("Greeting"),
+		WithModelType(ModelTypeQualityOptimized),
+		WithShowBilledCharacters(true),
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &Translations{Translations: []Translation{
+		{DetectedSourceLanguage: "EN", Text: "Hallo", BilledCharacters: 5},
+	}}, res)
+}
+
+// Test Client function TranslateText with XML tag handling options
+// Function must send tags options in request body
+func Test_Client_TranslateTextTagHandling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{
+				"text":["<p>Hello <b>World</b></p>"],
+				"target_lang":"DE",
+				"tag_handling":"xml",
+				"outline_detection":false,
+				"non_splitting_tags":["b"],
+				"splitting_tags":["p"],
+				"ignore_tags":["code","pre"]
+			}`, string(body))
+			w.Write([]byte(`{"translations":[{"detected_source_language":"EN","text":"<p>Hallo <b>Welt</b></p>"}]}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	_, err := c.TranslateText([]string{"<p>Hello <b>World</b></p>"}, "DE",
+		WithTagHandling(TagHandlingXML),
+		WithOutlineDetection(false),
+		WithNonSplittingTags("b"),
+		WithSplittingTags("p"),
+		WithIgnoreTags("code", "pre"),
+	)
+
+	assert.Nil(t, err)
+}
+
+// Test Client function TranslateText with malformed XML
+// Function must return MarkupError without calling API
+func Test_Client_TranslateTextMalformedXML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			t.Error("request must not be sent")
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	res, err := c.TranslateText([]string{"<p>Hello</p>", "<p>World</b>"}, "DE",
+		WithTagHandling(TagHandlingXML),
+	)
+
+	var markupErr *MarkupError
+	assert.Nil(t, res)
+	assert.True(t, errors.As(err, &markupErr))
+	assert.Equal(t, 1, markupErr.Index)
+}
+
+// Test Client function TranslateText with HTML tag handling
+// Function must not check markup as HTML accept unclosed elements
+func Test_Client_TranslateTextHTMLNotChecked(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"translations":[{"detected_source_language":"EN","text":"Hallo<br>"}]}`))
+		},
+	))
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY")
+	c.SetBaseUrl(server.URL)
+
+	_, err := c.TranslateText([]string{"Hello<br>"}, "DE",
+		WithTagHandling(TagHandlingHTML),
+	)
+
+	assert.Nil(t, err)
+}
+
+// Test Client function TranslateText with a text larger than a request
+// Function must split text instead of sending a request rejected by DeepL
+func Test_Client_TranslateTextLongText(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+
+	paragraph := strings.Repeat("Lorem ipsum dolor sit amet. ", 2000)
+	text := paragraph + "\n\n" + paragraph + "\n\n" + paragraph
+	res, err := c.TranslateText([]string{"Hello", text}, "DE")
+
+	assert.Nil(t, err)
+	assert.Greater(t, len(requests), 1)
+	assert.Equal(t, []string{"HELLO", strings.ToUpper(text)}, []string{res.Translations[0].Text, res.Translations[1].Text})
+
+	_, err = c.TranslateText([]string{"fail", text}, "DE")
+	assert.ErrorIs(t, err, ErrBadRequest)
+}
+
+// Test Client function TranslateText with more texts than a request accept
+// Function must send texts in several requests and keep their order
+func Test_Client_TranslateTextManyTexts(t *testing.T) {
+	requests := []int{}
+	server := newUpperServer(t, &requests)
+
+	defer server.Close()
+	c := NewClient("NO_API_KEY", WithServerURL(server.URL))
+
+	texts := make([]string, 120)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("text %d", i)
+	}
+	res, err := c.TranslateText(texts, "DE")
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []int{50, 50, 20}, requests)
+	if assert.Len(t, res.Translations, 120) {
+		assert.Equal(t, "TEXT 0", res.Translations[0].Text)
+		assert.Equal(t, "TEXT 119", res.Translations[119].Text)
+	}
+}
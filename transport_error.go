@@ -0,0 +1,93 @@
+package deeplgo
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+type TransportErrorKind int
+
+const (
+	TransportErrorOther TransportErrorKind = iota
+	TransportErrorTimeout
+	TransportErrorConnectionRefused
+	TransportErrorDNS
+	TransportErrorTLS
+)
+
+func (k TransportErrorKind) String() string {
+	switch k {
+	case TransportErrorTimeout:
+		return "timeout"
+	case TransportErrorConnectionRefused:
+		return "connection refused"
+	case TransportErrorDNS:
+		return "dns"
+	case TransportErrorTLS:
+		return "tls"
+	}
+
+	return "other"
+}
+
+// TransportError is returned when a request failed without response from
+// server, unlike APIError. Err is the error returned by http.Client.
+type TransportError struct {
+	Kind TransportErrorKind
+	Err  error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("transport error (%s): %v", e.Kind, e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Temporary tell if sending request again may succeed, a TLS error will not
+// go away by itself.
+func (e *TransportError) Temporary() bool {
+	return e.Kind != TransportErrorTLS
+}
+
+// notSent tell if request failed while connecting to server, so it never
+// reached DeepL and can be sent again whatever its method.
+func (e *TransportError) notSent() bool {
+	var opErr *net.OpError
+	return e.Kind == TransportErrorDNS || e.Kind == TransportErrorConnectionRefused ||
+		(errors.As(e.Err, &opErr) && opErr.Op == "dial")
+}
+
+func newTransportError(err error) *TransportError {
+	return &TransportError{Kind: transportErrorKind(err), Err: err}
+}
+
+func transportErrorKind(err error) TransportErrorKind {
+	var (
+		netErr         net.Error
+		dnsErr         *net.DNSError
+		unknownAuthErr x509.UnknownAuthorityError
+		hostnameErr    x509.HostnameError
+		certInvalidErr x509.CertificateInvalidError
+		recordErr      tls.RecordHeaderError
+	)
+
+	switch {
+	case errors.As(err, &unknownAuthErr), errors.As(err, &hostnameErr),
+		errors.As(err, &certInvalidErr), errors.As(err, &recordErr):
+		return TransportErrorTLS
+	case errors.As(err, &dnsErr):
+		return TransportErrorDNS
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return TransportErrorConnectionRefused
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return TransportErrorTimeout
+	}
+
+	return TransportErrorOther
+}
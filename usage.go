@@ -1,15 +1,21 @@
 package deeplgo
 
+import "context"
+
 type Usage struct {
 	CharacterCount int `json:"character_count"`
 	CharacterLimit int `json:"character_limit"`
 }
 
 func (c *Client) GetUsage() (*Usage, error) {
-	url := c.baseURL + usageEndpoint
+	return c.GetUsageContext(context.Background())
+}
+
+func (c *Client) GetUsageContext(ctx context.Context) (*Usage, error) {
+	url := c.endpointURL(usageEndpoint)
 
 	res := Usage{}
-	if err := c.httpClient.Get(url, []QueryParameter{}, &res); err != nil {
+	if err := c.httpClient.GetContext(ctx, url, []QueryParameter{}, &res); err != nil {
 		return nil, err
 	}
 